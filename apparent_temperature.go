@@ -0,0 +1,51 @@
+package libwx
+
+import "math"
+
+// ApparentTemperatureF calculates the apparent temperature used by the
+// Australian Bureau of Meteorology (Steadman, 1994) in the shade, given the
+// temperature (in Fahrenheit), relative humidity, and wind speed at 10 m (in
+// any speed type).
+// See ApparentTemperatureC for details.
+func ApparentTemperatureF[S Speed](temp TempF, rh RelHumidity, wind S) TempF {
+	return ApparentTemperatureC(temp.C(), rh, wind).F()
+}
+
+// ApparentTemperatureC calculates the apparent temperature used by the
+// Australian Bureau of Meteorology (Steadman, 1994) in the shade, given the
+// temperature (in Celsius), relative humidity, and wind speed at 10 m (in any
+// speed type).
+// See: http://www.bom.gov.au/info/thermal_stress/
+func ApparentTemperatureC[S Speed](temp TempC, rh RelHumidity, wind S) TempC {
+	e := bomVaporPressure(temp, rh)
+	return TempC(temp.Unwrap() + 0.33*e - 0.70*speedMps(wind).Unwrap() - 4.00)
+}
+
+// ApparentTemperatureWithRadiationF calculates the apparent temperature used
+// by the Australian Bureau of Meteorology (Steadman, 1994), including the
+// effect of radiation, given the temperature (in Fahrenheit), relative
+// humidity, wind speed at 10 m (in any speed type), and net radiation absorbed
+// per unit area of body surface.
+// See ApparentTemperatureWithRadiationC for details.
+func ApparentTemperatureWithRadiationF[S Speed](temp TempF, rh RelHumidity, wind S, netRadiation Irradiance) TempF {
+	return ApparentTemperatureWithRadiationC(temp.C(), rh, wind, netRadiation).F()
+}
+
+// ApparentTemperatureWithRadiationC calculates the apparent temperature used
+// by the Australian Bureau of Meteorology (Steadman, 1994), including the
+// effect of radiation, given the temperature (in Celsius), relative humidity,
+// wind speed at 10 m (in any speed type), and net radiation absorbed per unit
+// area of body surface.
+// See: http://www.bom.gov.au/info/thermal_stress/
+func ApparentTemperatureWithRadiationC[S Speed](temp TempC, rh RelHumidity, wind S, netRadiation Irradiance) TempC {
+	e := bomVaporPressure(temp, rh)
+	ws := speedMps(wind).Unwrap()
+	return TempC(temp.Unwrap() + 0.348*e - 0.70*ws + 0.70*netRadiation.Unwrap()/(ws+10) - 4.25)
+}
+
+// bomVaporPressure returns the water vapor pressure (in hPa) for the given
+// temperature and relative humidity, using the formula given by the Bureau of
+// Meteorology alongside the apparent temperature.
+func bomVaporPressure(temp TempC, rh RelHumidity) float64 {
+	return rh.Clamped().UnwrapFloat64() / 100.0 * 6.105 * math.Exp(17.27*temp.Unwrap()/(237.7+temp.Unwrap()))
+}
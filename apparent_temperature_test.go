@@ -0,0 +1,35 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ApparentTemperature(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance001)
+
+	cases := []struct {
+		t        TempC
+		rh       RelHumidity
+		wind     SpeedMps
+		expected TempC
+	}{
+		{TempC(30), RelHumidity(50), SpeedMps(2), TempC(31.577)},
+		{TempC(20), RelHumidity(70), SpeedMps(5), TempC(17.888)},
+		{TempC(40), RelHumidity(20), SpeedMps(0), TempC(40.848)},
+		{TempC(10), RelHumidity(80), SpeedMps(8), TempC(3.637)},
+	}
+
+	for _, c := range cases {
+		result := ApparentTemperatureC(c.t, c.rh, c.wind)
+		r.True(eq(result.Unwrap(), c.expected.Unwrap()), "given t %v + rh %v + wind %v: expected %v, got %v", c.t, c.rh, c.wind, c.expected, result)
+		r.True(eq(ApparentTemperatureC(c.t, c.rh, c.wind.KmH()).Unwrap(), c.expected.Unwrap()))
+		r.True(eq(ApparentTemperatureF(c.t.F(), c.rh, c.wind.Mph()).C().Unwrap(), c.expected.Unwrap()))
+	}
+
+	r.True(eq(ApparentTemperatureWithRadiationC(TempC(30), RelHumidity(50), SpeedMps(2), Irradiance(300)).Unwrap(), 49.208))
+	r.True(eq(ApparentTemperatureWithRadiationC(TempC(20), RelHumidity(70), SpeedKmH(18), Irradiance(0)).Unwrap(), 17.931))
+	r.True(eq(ApparentTemperatureWithRadiationF(TempC(30).F(), RelHumidity(50), SpeedMps(2).Knots(), Irradiance(300)).C().Unwrap(), 49.208))
+}
@@ -0,0 +1,80 @@
+package libwx
+
+import "math"
+
+const (
+	altimeterExponent = 0.190284
+	// altimeterK is (1013.25^n * standard lapse rate / standard sea-level temperature), in 1/m.
+	altimeterK = 8.42288e-5
+)
+
+// AltimeterSettingMb calculates the altimeter setting (QNH, in millibars) given
+// the station pressure (in millibars) and field elevation (in meters), per the
+// formula used by the NWS and FAA.
+// See: https://www.weather.gov/media/epz/wxcalc/altimeterSetting.pdf
+func AltimeterSettingMb(station PressureMb, elevation Meter) PressureMb {
+	p := station.Unwrap() - 0.3
+	return PressureMb(p * math.Pow(
+		1+altimeterK*elevation.Unwrap()/math.Pow(p, altimeterExponent),
+		1/altimeterExponent,
+	))
+}
+
+// AltimeterSettingInHg calculates the altimeter setting (QNH, in inches of
+// mercury) given the station pressure (in inches of mercury) and field
+// elevation (in feet), per the formula used by the NWS and FAA.
+// See: https://www.weather.gov/media/epz/wxcalc/altimeterSetting.pdf
+func AltimeterSettingInHg(station PressureInHg, elevation Feet) PressureInHg {
+	return AltimeterSettingMb(station.Mb(), elevation.Meters()).InHg()
+}
+
+// QFEMb calculates QFE (the atmospheric pressure at field elevation, in
+// millibars) given the altimeter setting (QNH, in millibars) and field
+// elevation (in meters). It is the inverse of AltimeterSettingMb.
+func QFEMb(altimeterSetting PressureMb, elevation Meter) PressureMb {
+	return PressureMb(math.Pow(
+		math.Pow(altimeterSetting.Unwrap(), altimeterExponent)-altimeterK*elevation.Unwrap(),
+		1/altimeterExponent,
+	) + 0.3)
+}
+
+// QFEInHg calculates QFE (the atmospheric pressure at field elevation, in
+// inches of mercury) given the altimeter setting (QNH, in inches of mercury)
+// and field elevation (in feet). It is the inverse of AltimeterSettingInHg.
+func QFEInHg(altimeterSetting PressureInHg, elevation Feet) PressureInHg {
+	return QFEMb(altimeterSetting.Mb(), elevation.Meters()).InHg()
+}
+
+// PressureAltitudeInHg calculates the pressure altitude (in feet) given the
+// station pressure (in inches of mercury).
+// See: https://www.weather.gov/media/epz/wxcalc/pressureAltitude.pdf
+func PressureAltitudeInHg(station PressureInHg) Feet {
+	return Feet(145366.45 * (1 - math.Pow(station.Mb().Unwrap()/1013.25, altimeterExponent)))
+}
+
+// PressureAltitudeMb calculates the pressure altitude (in meters) given the
+// station pressure (in millibars).
+// See: https://www.weather.gov/media/epz/wxcalc/pressureAltitude.pdf
+func PressureAltitudeMb(station PressureMb) Meter {
+	return PressureAltitudeInHg(station.InHg()).Meters()
+}
+
+// DensityAltitudeInHg calculates the density altitude (in feet) given the
+// station pressure (in inches of mercury), temperature and dew point (in
+// Fahrenheit). The effect of humidity is accounted for using virtual temperature.
+// See: https://www.weather.gov/media/epz/wxcalc/densityAltitude.pdf
+func DensityAltitudeInHg(station PressureInHg, temp, dewPoint TempF) Feet {
+	p := station.Mb().Unwrap()
+	e := magnusVaporPressure(dewPoint.C())
+	tvK := (temp.C().Unwrap() + 273.15) / (1 - (e/p)*(1-0.622))
+	tvR := tvK * 1.8
+	return Feet(145442.16 * (1 - math.Pow(17.326*station.Unwrap()/tvR, 0.235)))
+}
+
+// DensityAltitudeMb calculates the density altitude (in meters) given the
+// station pressure (in millibars), temperature and dew point (in Celsius).
+// The effect of humidity is accounted for using virtual temperature.
+// See: https://www.weather.gov/media/epz/wxcalc/densityAltitude.pdf
+func DensityAltitudeMb(station PressureMb, temp, dewPoint TempC) Meter {
+	return DensityAltitudeInHg(station.InHg(), temp.F(), dewPoint.F()).Meters()
+}
@@ -0,0 +1,62 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_AltimeterSetting(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance01)
+
+	cases := []struct {
+		station   PressureInHg
+		elevation Feet
+		expected  PressureInHg
+	}{
+		{PressureInHg(29.92), Feet(0), PressureInHg(29.91)},
+		{PressureInHg(24.90), Feet(5434), PressureInHg(30.39)},
+		{PressureInHg(28.50), Feet(1300), PressureInHg(29.87)},
+	}
+
+	for _, c := range cases {
+		result := AltimeterSettingInHg(c.station, c.elevation)
+		r.True(eq(result.Unwrap(), c.expected.Unwrap()), "given station pressure %v at %v ft: expected %v, got %v",
+			c.station, c.elevation, c.expected, result)
+
+		qfe := QFEInHg(result, c.elevation)
+		r.True(Float64Equal(qfe.Unwrap(), c.station.Unwrap(), Tolerance001), "QFE: expected %v, got %v", c.station, qfe)
+
+		resultMb := AltimeterSettingMb(c.station.Mb(), c.elevation.Meters())
+		r.True(Float64Equal(resultMb.InHg().Unwrap(), result.Unwrap(), Tolerance001))
+	}
+}
+
+func Test_PressureAltitude(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(5)
+
+	r.True(eq(PressureAltitudeInHg(PressureInHg(29.92)).Unwrap(), 0))
+	r.True(eq(PressureAltitudeInHg(PressureInHg(24.90)).Unwrap(), 4994))
+	r.True(eq(PressureAltitudeMb(PressureMb(1013.25)).Unwrap(), 0))
+	r.True(eq(PressureAltitudeMb(PressureMb(500)).Unwrap(), 5574))
+}
+
+func Test_DensityAltitude(t *testing.T) {
+	r := require.New(t)
+
+	// standard atmosphere at sea level
+	da := DensityAltitudeInHg(PressureInHg(29.92), TempF(59), TempF(-40))
+	r.True(Float64Equal(da.Unwrap(), 0, 25), "expected ~0 ft, got %v", da)
+
+	// a hot afternoon at Denver: pressure altitude ~5000 ft, OAT 32 C vs. ISA 5 C
+	dry := DensityAltitudeInHg(PressureInHg(24.90), TempF(90), TempF(20))
+	r.True(dry > 8000 && dry < 8800, "expected ~8400 ft, got %v", dry)
+
+	humid := DensityAltitudeInHg(PressureInHg(24.90), TempF(90), TempF(60))
+	r.Greater(humid.Unwrap(), dry.Unwrap())
+
+	daMb := DensityAltitudeMb(PressureInHg(24.90).Mb(), TempF(90).C(), TempF(60).C())
+	r.True(Float64Equal(daMb.Feet().Unwrap(), humid.Unwrap(), Tolerance01))
+}
@@ -0,0 +1,135 @@
+package libwx
+
+import "math"
+
+// PMVF calculates the Predicted Mean Vote (ISO 7730) given the air temperature
+// and mean radiant temperature (in Fahrenheit), relative air speed (in any
+// speed type), relative humidity, metabolic rate, and clothing insulation.
+// See PMVC for details.
+func PMVF[S Speed](temp, meanRadiant TempF, airSpeed S, rh RelHumidity, met Met, clo Clo) (float64, error) {
+	return PMVC(temp.C(), meanRadiant.C(), airSpeed, rh, met, clo)
+}
+
+// PMVC calculates the Predicted Mean Vote (ISO 7730) given the air temperature
+// and mean radiant temperature (in Celsius), relative air speed (in any speed
+// type), relative humidity, metabolic rate, and clothing insulation.
+//
+// PMV predicts the mean thermal sensation vote of a large group of people on
+// the 7-point scale from -3 (cold) to +3 (hot). No external work is assumed.
+// If the inputs are outside the ranges recommended by ISO 7730 (air temperature
+// 10-30 °C, mean radiant temperature 10-40 °C, air speed 0-1 m/s, metabolic
+// rate 0.8-4 met, clothing 0-2 clo, vapor pressure up to 2700 Pa), the PMV is
+// returned along with ErrInputRange. If the clothing surface temperature
+// cannot be found, 0 and ErrInputRange are returned.
+func PMVC[S Speed](temp, meanRadiant TempC, airSpeed S, rh RelHumidity, met Met, clo Clo) (float64, error) {
+	ta := temp.Unwrap()
+	tr := meanRadiant.Unwrap()
+	vel := speedMps(airSpeed).Unwrap()
+	pa := rh.Clamped().UnwrapFloat64() * 10 * math.Exp(16.6536-4030.183/(ta+235)) // Pa
+
+	icl := 0.155 * clo.Unwrap() // m²·K/W
+	m := met.Unwrap() * 58.15   // W/m²
+	mw := m                     // no external work
+
+	fcl := 1.05 + 0.645*icl
+	if icl <= 0.078 {
+		fcl = 1 + 1.29*icl
+	}
+	hcf := 12.1 * math.Sqrt(vel)
+	taa := ta + 273
+	tra := tr + 273
+
+	// iteratively find the clothing surface temperature
+	tcla := taa + (35.5-ta)/(3.5*icl+0.1)
+	p1 := icl * fcl
+	p2 := p1 * 3.96
+	p3 := p1 * 100
+	p4 := p1 * taa
+	p5 := 308.7 - 0.028*mw + p2*math.Pow(tra/100, 4)
+	xn := tcla / 100
+	xf := tcla / 50
+	hc := hcf
+	for i := 0; math.Abs(xn-xf) > 0.00015; i++ {
+		if i > 150 {
+			return 0, ErrInputRange
+		}
+		xf = (xf + xn) / 2
+		hcn := 2.38 * math.Pow(math.Abs(100*xf-taa), 0.25)
+		hc = math.Max(hcf, hcn)
+		xn = (p5 + p4*hc - p2*math.Pow(xf, 4)) / (100 + p3*hc)
+	}
+	tcl := 100*xn - 273
+
+	// heat loss components
+	hl1 := 3.05e-3 * (5733 - 6.99*mw - pa) // skin diffusion
+	hl2 := 0.0                             // sweating
+	if mw > 58.15 {
+		hl2 = 0.42 * (mw - 58.15)
+	}
+	hl3 := 1.7e-5 * m * (5867 - pa)                              // latent respiration
+	hl4 := 0.0014 * m * (34 - ta)                                // dry respiration
+	hl5 := 3.96 * fcl * (math.Pow(xn, 4) - math.Pow(tra/100, 4)) // radiation
+	hl6 := fcl * hc * (tcl - ta)                                 // convection
+
+	ts := 0.303*math.Exp(-0.036*m) + 0.028
+	pmv := ts * (mw - hl1 - hl2 - hl3 - hl4 - hl5 - hl6)
+
+	var err error
+	if ta < 10 || ta > 30 || tr < 10 || tr > 40 || vel < 0 || vel > 1 ||
+		met < 0.8 || met > 4 || clo < 0 || clo > 2 || pa > 2700 {
+		err = ErrInputRange
+	}
+	return pmv, err
+}
+
+// PPD calculates the Predicted Percentage Dissatisfied (ISO 7730) for the
+// given Predicted Mean Vote.
+func PPD(pmv float64) float64 {
+	return 100 - 95*math.Exp(-0.03353*math.Pow(pmv, 4)-0.2179*math.Pow(pmv, 2))
+}
+
+// AdaptiveComfort describes the ASHRAE 55 adaptive thermal comfort assessment
+// of an operative temperature.
+type AdaptiveComfort[T TempC | TempF] struct {
+	// Neutral is the comfort (neutral) operative temperature.
+	Neutral T
+	// Acceptable80 is true if the operative temperature is within the 80%
+	// acceptability limits.
+	Acceptable80 bool
+	// Acceptable90 is true if the operative temperature is within the 90%
+	// acceptability limits.
+	Acceptable90 bool
+}
+
+// AdaptiveComfortF assesses the given operative temperature (in Fahrenheit)
+// against the ASHRAE 55 adaptive comfort model, given the prevailing mean
+// outdoor air temperature (in Fahrenheit).
+// See AdaptiveComfortC for details.
+func AdaptiveComfortF(operative, prevailingOutdoor TempF) (AdaptiveComfort[TempF], error) {
+	c, err := AdaptiveComfortC(operative.C(), prevailingOutdoor.C())
+	return AdaptiveComfort[TempF]{
+		Neutral:      c.Neutral.F(),
+		Acceptable80: c.Acceptable80,
+		Acceptable90: c.Acceptable90,
+	}, err
+}
+
+// AdaptiveComfortC assesses the given operative temperature (in Celsius)
+// against the ASHRAE 55 adaptive comfort model, given the prevailing mean
+// outdoor air temperature (in Celsius).
+//
+// The adaptive model applies to occupant-controlled, naturally conditioned
+// spaces. It is defined for prevailing mean outdoor temperatures from 10 to
+// 33.5 °C; outside this range, ErrInputRange is returned.
+func AdaptiveComfortC(operative, prevailingOutdoor TempC) (AdaptiveComfort[TempC], error) {
+	if prevailingOutdoor < 10 || prevailingOutdoor > 33.5 {
+		return AdaptiveComfort[TempC]{}, ErrInputRange
+	}
+	neutral := 0.31*prevailingOutdoor.Unwrap() + 17.8
+	diff := math.Abs(operative.Unwrap() - neutral)
+	return AdaptiveComfort[TempC]{
+		Neutral:      TempC(neutral),
+		Acceptable80: diff <= 3.5,
+		Acceptable90: diff <= 2.5,
+	}, nil
+}
@@ -0,0 +1,76 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_PMV(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance001)
+
+	// from ISO 7730:2005 Annex D, Table D.1
+	cases := []struct {
+		t    TempC
+		tmrt TempC
+		vel  SpeedMps
+		rh   RelHumidity
+		met  Met
+		clo  Clo
+		pmv  float64
+		ppd  float64
+	}{
+		{TempC(22), TempC(22), SpeedMps(0.1), RelHumidity(60), Met(1.2), Clo(0.5), -0.75, 17},
+		{TempC(27), TempC(27), SpeedMps(0.1), RelHumidity(60), Met(1.2), Clo(0.5), 0.77, 17},
+		{TempC(23.5), TempC(25.5), SpeedMps(0.1), RelHumidity(60), Met(1.2), Clo(0.5), -0.01, 5},
+		{TempC(19), TempC(18), SpeedMps(0.1), RelHumidity(40), Met(1.2), Clo(1.0), -0.70, 15},
+	}
+
+	for _, c := range cases {
+		pmv, err := PMVC(c.t, c.tmrt, c.vel, c.rh, c.met, c.clo)
+		r.NoError(err)
+		r.True(Float64Equal(pmv, c.pmv, 0.015), "given %+v: expected pmv %v, got %v", c, c.pmv, pmv)
+		r.True(Float64Equal(PPD(pmv), c.ppd, 0.5), "given %+v: expected ppd %v, got %v", c, c.ppd, PPD(pmv))
+
+		pmvF, err := PMVF(c.t.F(), c.tmrt.F(), c.vel.Mph(), c.rh, c.met, c.clo)
+		r.NoError(err)
+		r.True(eq(pmvF, pmv))
+	}
+
+	r.True(eq(PPD(0), 5))
+
+	pmv, err := PMVC(TempC(35), TempC(35), SpeedMps(0.1), RelHumidity(50), Met(1.2), Clo(0.5))
+	r.ErrorIs(err, ErrInputRange)
+	r.Greater(pmv, 2.0)
+}
+
+func Test_AdaptiveComfort(t *testing.T) {
+	r := require.New(t)
+
+	c, err := AdaptiveComfortC(TempC(25), TempC(20))
+	r.NoError(err)
+	r.True(Float64Equal(c.Neutral.Unwrap(), 24, Tolerance001))
+	r.True(c.Acceptable80)
+	r.True(c.Acceptable90)
+
+	c, err = AdaptiveComfortC(TempC(27), TempC(20))
+	r.NoError(err)
+	r.True(c.Acceptable80)
+	r.False(c.Acceptable90)
+
+	c, err = AdaptiveComfortC(TempC(28), TempC(20))
+	r.NoError(err)
+	r.False(c.Acceptable80)
+	r.False(c.Acceptable90)
+
+	cF, err := AdaptiveComfortF(TempF(77), TempC(20).F())
+	r.NoError(err)
+	r.True(Float64Equal(cF.Neutral.C().Unwrap(), 24, Tolerance001))
+	r.True(cF.Acceptable90)
+
+	_, err = AdaptiveComfortC(TempC(25), TempC(5))
+	r.ErrorIs(err, ErrInputRange)
+	_, err = AdaptiveComfortF(TempF(77), TempF(95))
+	r.ErrorIs(err, ErrInputRange)
+}
@@ -0,0 +1,10 @@
+package libwx
+
+// Met represents metabolic rate in met units (1 met = 58.15 W/m²).
+type Met float64
+
+// Clo represents clothing insulation in clo units (1 clo = 0.155 m²·K/W).
+type Clo float64
+
+func (m Met) Unwrap() float64 { return float64(m) }
+func (c Clo) Unwrap() float64 { return float64(c) }
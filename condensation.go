@@ -0,0 +1,88 @@
+package libwx
+
+import "math"
+
+// interiorSurfaceResistance is the conventional interior surface thermal
+// resistance (in m²·K/W) for heat flow through a vertical surface, per ISO 6946.
+const interiorSurfaceResistance = 0.13
+
+// SurfaceTemperatureF estimates the interior surface temperature (in
+// Fahrenheit) of a window or wall with the given U-value, given the indoor and
+// outdoor temperatures (in Fahrenheit), under steady-state conditions.
+func SurfaceTemperatureF(indoor, outdoor TempF, u UValue) TempF {
+	return SurfaceTemperatureC(indoor.C(), outdoor.C(), u).F()
+}
+
+// SurfaceTemperatureC estimates the interior surface temperature (in Celsius)
+// of a window or wall with the given U-value, given the indoor and outdoor
+// temperatures (in Celsius), under steady-state conditions.
+func SurfaceTemperatureC(indoor, outdoor TempC, u UValue) TempC {
+	return TempC(indoor.Unwrap() - u.Unwrap()*interiorSurfaceResistance*(indoor.Unwrap()-outdoor.Unwrap()))
+}
+
+// MaxIndoorRelHumidityF returns the maximum indoor relative humidity at which
+// condensation will not form on the interior surface of a window or wall with
+// the given U-value, given the indoor and outdoor temperatures (in Fahrenheit).
+// See MaxIndoorRelHumidityC for details.
+func MaxIndoorRelHumidityF(indoor, outdoor TempF, u UValue) RelHumidity {
+	return MaxIndoorRelHumidityC(indoor.C(), outdoor.C(), u)
+}
+
+// MaxIndoorRelHumidityC returns the maximum indoor relative humidity at which
+// condensation will not form on the interior surface of a window or wall with
+// the given U-value, given the indoor and outdoor temperatures (in Celsius).
+//
+// This is a continuous, building-specific alternative to
+// IndoorHumidityRecommendationC. The result is rounded down, so that the
+// indoor dew point (per DewPointC) does not exceed the surface temperature.
+func MaxIndoorRelHumidityC(indoor, outdoor TempC, u UValue) RelHumidity {
+	surface := SurfaceTemperatureC(indoor, outdoor, u).Unwrap()
+	t := indoor.Unwrap()
+	gamma := magnusA*surface/(magnusB+surface) - magnusA*t/(magnusB+t)
+	return ClampedRelHumidity(int(math.Floor(100.0 * math.Exp(gamma))))
+}
+
+// SurfaceRelHumidityF returns the relative humidity of the air immediately
+// adjacent to a surface at the given temperature (in Fahrenheit), given the
+// temperature (in Fahrenheit) and relative humidity of the surrounding air.
+// See SurfaceRelHumidityC for details.
+func SurfaceRelHumidityF(surface, air TempF, rh RelHumidity) RelHumidity {
+	return SurfaceRelHumidityC(surface.C(), air.C(), rh)
+}
+
+// SurfaceRelHumidityC returns the relative humidity of the air immediately
+// adjacent to a surface at the given temperature (in Celsius), given the
+// temperature (in Celsius) and relative humidity of the surrounding air.
+//
+// The air's vapor pressure is assumed to be unchanged at the surface, so a
+// surface colder than the air has a higher relative humidity. This is the
+// humidity that governs mold growth on the surface (see MoldGrowth).
+func SurfaceRelHumidityC(surface, air TempC, rh RelHumidity) RelHumidity {
+	return relHumidityAtTemp(air, rh, surface)
+}
+
+// relHumidityAtTemp returns the relative humidity of air at temperature `from`
+// with the given relative humidity, once brought to temperature `to` with its
+// vapor pressure unchanged. Supersaturation is clamped to 100%.
+func relHumidityAtTemp(from TempC, rh RelHumidity, to TempC) RelHumidity {
+	e := rh.Clamped().UnwrapFloat64() / 100.0 * magnusVaporPressure(from)
+	return ClampedRelHumidity(int(e/magnusVaporPressure(to)*100.0 + 0.5))
+}
+
+// CondensationRiskF returns the margin between the given surface temperature
+// (in Fahrenheit) and the dew point of the surrounding air, given its
+// temperature (in Fahrenheit) and relative humidity, and whether condensation
+// will form on the surface (i.e. whether the margin is not positive).
+func CondensationRiskF(surface, air TempF, rh RelHumidity) (TempF, bool) {
+	margin := surface - DewPointF(air, rh)
+	return margin, margin <= 0
+}
+
+// CondensationRiskC returns the margin between the given surface temperature
+// (in Celsius) and the dew point of the surrounding air, given its temperature
+// (in Celsius) and relative humidity, and whether condensation will form on the
+// surface (i.e. whether the margin is not positive).
+func CondensationRiskC(surface, air TempC, rh RelHumidity) (TempC, bool) {
+	margin := surface - DewPointC(air, rh)
+	return margin, margin <= 0
+}
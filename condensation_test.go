@@ -0,0 +1,80 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SurfaceTemperature(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance001)
+
+	r.True(eq(SurfaceTemperatureC(TempC(21), TempC(-10), UValueSinglePane).Unwrap(), -2.374))
+	r.True(eq(SurfaceTemperatureC(TempC(21), TempC(-10), UValueDoublePane).Unwrap(), 9.716))
+	r.True(eq(SurfaceTemperatureC(TempC(21), TempC(21), UValueDoublePane).Unwrap(), 21))
+	r.True(eq(SurfaceTemperatureF(TempC(21).F(), TempC(-10).F(), UValueTriplePaneLowE).C().Unwrap(), 17.776))
+}
+
+func Test_MaxIndoorRelHumidity(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		outdoor  TempC
+		u        UValue
+		expected RelHumidity
+	}{
+		{TempC(-20), UValueSinglePane, RelHumidity(11)},
+		{TempC(0), UValueSinglePane, RelHumidity(35)},
+		{TempC(-10), UValueDoublePane, RelHumidity(48)},
+		{TempC(-10), UValueDoublePaneLowE, RelHumidity(63)},
+		{TempC(-20), UValueTriplePaneLowE, RelHumidity(76)},
+		{TempC(10), UValueTriplePaneLowE, RelHumidity(93)},
+		{TempC(25), UValueSinglePane, RelHumidity(100)},
+	}
+
+	for _, c := range cases {
+		result := MaxIndoorRelHumidityC(TempC(21), c.outdoor, c.u)
+		r.Equal(c.expected, result, "given outdoor %v + u %v", c.outdoor, c.u)
+		r.Equal(c.expected, MaxIndoorRelHumidityF(TempC(21).F(), c.outdoor.F(), c.u))
+
+		surface := SurfaceTemperatureC(TempC(21), c.outdoor, c.u)
+		r.LessOrEqual(DewPointC(TempC(21), result).Unwrap(), surface.Unwrap()+1e-9)
+		if result < 100 {
+			r.Greater(DewPointC(TempC(21), result+1).Unwrap(), surface.Unwrap())
+		}
+	}
+
+	// better windows allow higher indoor humidity
+	r.Less(
+		MaxIndoorRelHumidityF(TempF(70), TempF(0), UValueDoublePane),
+		MaxIndoorRelHumidityF(TempF(70), TempF(0), UValueDoublePaneLowE),
+	)
+}
+
+func Test_SurfaceRelHumidity(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(RelHumidity(50), SurfaceRelHumidityC(TempC(20), TempC(20), RelHumidity(50)))
+	r.Equal(RelHumidity(69), SurfaceRelHumidityC(TempC(15), TempC(20), RelHumidity(50)))
+	r.Equal(RelHumidity(100), SurfaceRelHumidityC(TempC(5), TempC(20), RelHumidity(50)))
+	r.Equal(RelHumidity(69), SurfaceRelHumidityF(TempC(15).F(), TempC(20).F(), RelHumidity(50)))
+}
+
+func Test_CondensationRisk(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance01)
+
+	// the dew point at 20 °C, 50% RH is 9.26 °C
+	margin, risk := CondensationRiskC(TempC(12), TempC(20), RelHumidity(50))
+	r.False(risk)
+	r.True(eq(margin.Unwrap(), 2.74), "expected 2.74, got %v", margin)
+
+	margin, risk = CondensationRiskC(TempC(8), TempC(20), RelHumidity(50))
+	r.True(risk)
+	r.True(eq(margin.Unwrap(), -1.26), "expected -1.26, got %v", margin)
+
+	marginF, risk := CondensationRiskF(TempF(45), TempF(68), RelHumidity(50))
+	r.True(risk)
+	r.True(eq(marginF.Unwrap(), -3.67), "expected -3.67, got %v", marginF)
+}
@@ -0,0 +1,6 @@
+package libwx
+
+// Density represents mass density (e.g. air density) in kilograms per cubic meter.
+type Density float64
+
+func (d Density) Unwrap() float64 { return float64(d) }
@@ -15,6 +15,11 @@ func (mi Mile) Meters() Meter {
 	return Meter(mi * 1609.34)
 }
 
+// Feet returns the distance in feet.
+func (mi Mile) Feet() Feet {
+	return Feet(mi * 5280)
+}
+
 // Miles returns the distance in miles.
 func (km Km) Miles() Mile {
 	return Mile(km / 1.60934)
@@ -30,6 +35,11 @@ func (km Km) Meters() Meter {
 	return Meter(km * 1000)
 }
 
+// Feet returns the distance in feet.
+func (km Km) Feet() Feet {
+	return Feet(km * 1000 / 0.3048)
+}
+
 // Miles returns the distance in miles.
 func (nm NauticalMile) Miles() Mile {
 	return Mile(nm * 1.15078)
@@ -45,6 +55,11 @@ func (nm NauticalMile) Meters() Meter {
 	return Meter(nm * 1852)
 }
 
+// Feet returns the distance in feet.
+func (nm NauticalMile) Feet() Feet {
+	return Feet(nm * 1852 / 0.3048)
+}
+
 // Miles returns the distance in miles.
 func (m Meter) Miles() Mile {
 	return Mile(m / 1609.34)
@@ -59,3 +74,28 @@ func (m Meter) Km() Km {
 func (m Meter) NauticalMiles() NauticalMile {
 	return NauticalMile(m / 1852)
 }
+
+// Feet returns the distance in feet.
+func (m Meter) Feet() Feet {
+	return Feet(m / 0.3048)
+}
+
+// Miles returns the distance in miles.
+func (ft Feet) Miles() Mile {
+	return Mile(ft / 5280)
+}
+
+// Km returns the distance in kilometers.
+func (ft Feet) Km() Km {
+	return Km(ft * 0.3048 / 1000)
+}
+
+// NauticalMiles returns the distance in nautical miles.
+func (ft Feet) NauticalMiles() NauticalMile {
+	return NauticalMile(ft * 0.3048 / 1852)
+}
+
+// Meters returns the distance in meters.
+func (ft Feet) Meters() Meter {
+	return Meter(ft * 0.3048)
+}
@@ -12,7 +12,11 @@ type Km float64
 // NauticalMile represents distance in nautical miles.
 type NauticalMile float64
 
+// Feet represents distance in feet.
+type Feet float64
+
 func (mi Mile) Unwrap() float64         { return float64(mi) }
 func (m Meter) Unwrap() float64         { return float64(m) }
 func (km Km) Unwrap() float64           { return float64(km) }
 func (nm NauticalMile) Unwrap() float64 { return float64(nm) }
+func (ft Feet) Unwrap() float64         { return float64(ft) }
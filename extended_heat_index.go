@@ -0,0 +1,292 @@
+package libwx
+
+import "math"
+
+// This is a port of the reference implementation of the extended heat index
+// from Lu & Romps (2022), "Extending the Heat Index", Journal of Applied
+// Meteorology and Climatology 61(10). It solves Steadman's (1979) model of
+// human thermoregulation, extended so that it is valid for all combinations
+// of temperature and relative humidity.
+// See: https://doi.org/10.1175/JAMC-D-22-0021.1
+
+// Thermodynamic parameters
+const (
+	ehiTtrip = 273.16   // K
+	ehiPtrip = 611.65   // Pa
+	ehiE0v   = 2.3740e6 // J/kg
+	ehiE0s   = 0.3337e6 // J/kg
+	ehiRgasa = 287.04   // J/kg/K
+	ehiRgasv = 461.     // J/kg/K
+	ehiCva   = 719.     // J/kg/K
+	ehiCvv   = 1418.    // J/kg/K
+	ehiCvl   = 4119.    // J/kg/K
+	ehiCvs   = 1861.    // J/kg/K
+	ehiCpa   = ehiCva + ehiRgasa
+	ehiCpv   = ehiCvv + ehiRgasv
+)
+
+// Thermoregulatory parameters
+const (
+	ehiSigma   = 5.67e-8 // W/m^2/K^4, Stefan-Boltzmann constant
+	ehiEpsilon = 0.97    // emissivity of surface
+	ehiM       = 83.6    // kg, mass of average US adult
+	ehiH       = 1.69    // m, height of average US adult
+	ehiCpc     = 3492.   // J/kg/K, specific heat capacity of core
+	ehiR       = 124.    // Pa/K, Zf/Rf
+	ehiQ       = 180.    // W/m^2, metabolic rate per skin area
+	ehiPhiSalt = 0.9     // vapor saturation pressure level of saline solution
+	ehiTc      = 310.    // K, core temperature
+	ehiP       = 1.013e5 // Pa, atmospheric pressure
+	ehiEta     = 1.43e-6 // kg/J, "inhaled mass" / "metabolic rate"
+	ehiPa0     = 1.6e3   // Pa, reference air vapor pressure in regions III-VI
+
+	ehiZa    = 60.6 / 17.4 // Pa m^2/W, mass transfer resistance of the air layer around the skin
+	ehiZaBar = 60.6 / 11.6 // Pa m^2/W, mass transfer resistance of the air layer around the clothing
+	ehiZaUn  = 60.6 / 12.3 // Pa m^2/W, mass transfer resistance of the air layer around unclothed skin
+)
+
+var (
+	ehiA  = 0.202 * math.Pow(ehiM, 0.425) * math.Pow(ehiH, 0.725) // m^2, DuBois formula
+	ehiC  = ehiM * ehiCpc / ehiA                                  // heat capacity of core
+	ehiPc = ehiPhiSalt * ehiPvstar(ehiTc)                         // core vapor pressure
+	ehiL  = ehiLe(310.)                                           // latent heat of vaporization at 310 K
+)
+
+// ehiRegion identifies which physiological variable is at equilibrium in
+// Steadman's model for the given conditions.
+type ehiRegion int
+
+const (
+	ehiRegionPhi   ehiRegion = iota // region I: covering fraction
+	ehiRegionRf                     // regions II & III: clothing resistance
+	ehiRegionRs                     // regions IV & V: skin resistance
+	ehiRegionDTcdt                  // region VI: rate of change of core temperature
+)
+
+// ExtendedHeatIndexF calculates the extended heat index (Lu & Romps, 2022) for
+// the given temperature (in Fahrenheit) and relative humidity percentage.
+//
+// Unlike the Rothfusz regression used by HeatIndexFWithValidation and
+// HeatIndexNWSF, the extended heat index is physically based and valid for all
+// combinations of temperature and relative humidity, including extreme heat
+// and humidity. Its result may be used with HeatIndexWarningF.
+// If the temperature is at or below absolute zero, or no solution can be
+// found, ErrInputRange is returned.
+func ExtendedHeatIndexF(temp TempF, rh RelHumidity) (TempF, error) {
+	result, err := ExtendedHeatIndexC(temp.C(), rh)
+	return result.F(), err
+}
+
+// ExtendedHeatIndexC calculates the extended heat index (Lu & Romps, 2022) for
+// the given temperature (in Celsius) and relative humidity percentage.
+// Its result may be used with HeatIndexWarningC.
+//
+// See ExtendedHeatIndexF for details.
+func ExtendedHeatIndexC(temp TempC, rh RelHumidity) (TempC, error) {
+	ta := temp.Unwrap() + 273.15
+	if !(ta > 0) || math.IsInf(ta, 0) {
+		return temp, ErrInputRange
+	}
+	e, err := ehiFindEqvar(ta, rh.Clamped().UnwrapFloat64()/100.0)
+	if err != nil {
+		return temp, err
+	}
+	t, err := ehiFindT(e)
+	if err != nil {
+		return temp, err
+	}
+	return TempC(t - 273.15), nil
+}
+
+// ehiPvstar returns the saturation vapor pressure (in Pa) at the given
+// temperature (in K), over liquid water or ice.
+func ehiPvstar(t float64) float64 {
+	if t == 0 {
+		return 0
+	}
+	if t < ehiTtrip {
+		return ehiPtrip * math.Pow(t/ehiTtrip, (ehiCpv-ehiCvs)/ehiRgasv) *
+			math.Exp((ehiE0v+ehiE0s-(ehiCvv-ehiCvs)*ehiTtrip)/ehiRgasv*(1/ehiTtrip-1/t))
+	}
+	return ehiPtrip * math.Pow(t/ehiTtrip, (ehiCpv-ehiCvl)/ehiRgasv) *
+		math.Exp((ehiE0v-(ehiCvv-ehiCvl)*ehiTtrip)/ehiRgasv*(1/ehiTtrip-1/t))
+}
+
+// ehiLe returns the latent heat of vaporization of water (in J/kg) at the
+// given temperature (in K).
+func ehiLe(t float64) float64 {
+	return ehiE0v + (ehiCvv-ehiCvl)*(t-ehiTtrip) + ehiRgasv*t
+}
+
+// ehiQv returns the respiratory heat loss (in W/m^2).
+func ehiQv(ta, pa float64) float64 {
+	return ehiEta * ehiQ * (ehiCpa*(ehiTc-ta) + ehiL*ehiRgasa/(ehiP*ehiRgasv)*(ehiPc-pa))
+}
+
+// ehiZs returns the mass transfer resistance of the skin (in m^2 Pa/W).
+func ehiZs(rs float64) float64 {
+	if rs == 0.0387 {
+		return 52.1
+	}
+	return 6.0e8 * math.Pow(rs, 5)
+}
+
+// ehiRa returns a heat transfer resistance (in K m^2/W) for the given
+// convective heat transfer coefficient and radiative view factor.
+func ehiRa(ts, ta, hc, phiRad float64) float64 {
+	hr := ehiEpsilon * phiRad * ehiSigma * (ts*ts + ta*ta) * (ts + ta)
+	return 1 / (hc + hr)
+}
+
+// ehiRaSkin returns the heat transfer resistance of the air layer around the skin.
+func ehiRaSkin(ts, ta float64) float64 { return ehiRa(ts, ta, 17.4, 0.85) }
+
+// ehiRaBar returns the heat transfer resistance of the air layer around the clothing.
+func ehiRaBar(tf, ta float64) float64 { return ehiRa(tf, ta, 11.6, 0.79) }
+
+// ehiRaUn returns the heat transfer resistance of the air layer around unclothed skin.
+func ehiRaUn(ts, ta float64) float64 { return ehiRa(ts, ta, 12.3, 0.80) }
+
+// ehiEqvar holds the solution of the thermoregulatory model: the region, and
+// the physiological variables.
+type ehiEqvar struct {
+	region ehiRegion
+	phi    float64 // covering fraction
+	rf     float64 // heat transfer resistance of clothing, K m^2/W
+	rs     float64 // heat transfer resistance of skin, K m^2/W
+	dTcdt  float64 // rate of change of core temperature, K/s
+}
+
+// value returns the physiological variable that is at equilibrium in the given region.
+func (e ehiEqvar) value(region ehiRegion) float64 {
+	switch region {
+	case ehiRegionPhi:
+		return e.phi
+	case ehiRegionRf:
+		return e.rf
+	case ehiRegionRs:
+		return e.rs
+	default:
+		return e.dTcdt
+	}
+}
+
+// ehiFindEqvar solves the thermoregulatory model for the given air temperature
+// (in K) and relative humidity (as a fraction).
+func ehiFindEqvar(ta, rh float64) (ehiEqvar, error) {
+	pa := rh * ehiPvstar(ta) // Pa, vapor pressure
+	rs := 0.0387             // m^2K/W, heat transfer resistance of skin
+	phi := 0.84              // covering fraction
+	m := (ehiPc - pa) / (ehiZs(rs) + ehiZa)
+	mBar := (ehiPc - pa) / (ehiZs(rs) + ehiZaBar)
+	qNet := ehiQ - ehiQv(ta, pa)
+
+	ts, err := bisect(func(ts float64) float64 {
+		return (ts-ta)/ehiRaSkin(ts, ta) + (ehiPc-pa)/(ehiZs(rs)+ehiZa) - (ehiTc-ts)/rs
+	}, math.Max(0, math.Min(ehiTc, ta)-rs*math.Abs(m)), math.Max(ehiTc, ta)+rs*math.Abs(m))
+	if err != nil {
+		return ehiEqvar{}, err
+	}
+	tf, err := bisect(func(tf float64) float64 {
+		return (tf-ta)/ehiRaBar(tf, ta) + (ehiPc-pa)/(ehiZs(rs)+ehiZaBar) - (ehiTc-tf)/rs
+	}, math.Max(0, math.Min(ehiTc, ta)-rs*math.Abs(mBar)), math.Max(ehiTc, ta)+rs*math.Abs(mBar))
+	if err != nil {
+		return ehiEqvar{}, err
+	}
+
+	flux1 := qNet - (1-phi)*(ehiTc-ts)/rs                     // C*dTc/dt when Rf=Zf=inf
+	flux2 := qNet - (1-phi)*(ehiTc-ts)/rs - phi*(ehiTc-tf)/rs // C*dTc/dt when Rf=Zf=0
+
+	if flux1 <= 0 { // region I
+		return ehiEqvar{
+			region: ehiRegionPhi,
+			phi:    1 - qNet*rs/(ehiTc-ts),
+			rf:     math.Inf(1),
+			rs:     rs,
+		}, nil
+	}
+
+	if flux2 <= 0 { // regions II & III
+		tsBar := ehiTc - qNet*rs/phi + (1/phi-1)*(ehiTc-ts)
+		tf, err = bisect(func(tf float64) float64 {
+			return (tf-ta)/ehiRaBar(tf, ta) +
+				(ehiPc-pa)*(tf-ta)/((ehiZs(rs)+ehiZaBar)*(tf-ta)+ehiR*ehiRaBar(tf, ta)*(tsBar-tf)) -
+				(ehiTc-tsBar)/rs
+		}, ta, tsBar)
+		if err != nil {
+			return ehiEqvar{}, err
+		}
+		return ehiEqvar{
+			region: ehiRegionRf,
+			phi:    phi,
+			rf:     ehiRaBar(tf, ta) * (tsBar - tf) / (tf - ta),
+			rs:     rs,
+		}, nil
+	}
+
+	// regions IV, V & VI
+	flux3 := qNet - (ehiTc-ta)/ehiRaUn(ehiTc, ta) - (ehiPhiSalt*ehiPvstar(ehiTc)-pa)/ehiZaUn
+	if flux3 >= 0 { // region VI
+		return ehiEqvar{
+			region: ehiRegionDTcdt,
+			phi:    phi,
+			dTcdt:  (1 / ehiC) * flux3,
+		}, nil
+	}
+
+	// regions IV & V
+	ts, err = bisect(func(ts float64) float64 {
+		return (ts-ta)/ehiRaUn(ts, ta) + (ehiPc-pa)/(ehiZs((ehiTc-ts)/qNet)+ehiZaUn) - qNet
+	}, 0, ehiTc)
+	if err != nil {
+		return ehiEqvar{}, err
+	}
+	rs = (ehiTc - ts) / qNet
+	ps := ehiPc - (ehiPc-pa)*ehiZs(rs)/(ehiZs(rs)+ehiZaUn)
+	if ps > ehiPhiSalt*ehiPvstar(ts) { // region V
+		ts, err = bisect(func(ts float64) float64 {
+			return (ts-ta)/ehiRaUn(ts, ta) + (ehiPhiSalt*ehiPvstar(ts)-pa)/ehiZaUn - qNet
+		}, 0, ehiTc)
+		if err != nil {
+			return ehiEqvar{}, err
+		}
+		rs = (ehiTc - ts) / qNet
+	}
+	return ehiEqvar{
+		region: ehiRegionRs,
+		phi:    phi,
+		rs:     rs,
+	}, nil
+}
+
+// ehiFindT finds the temperature (in K) at which air at the reference humidity
+// gives the same value of the equilibrium variable: the heat index.
+func ehiFindT(e ehiEqvar) (float64, error) {
+	refRH := func(t float64) float64 { return ehiPa0 / ehiPvstar(t) }
+	lo, hi := 340.0, 1000.0
+	switch e.region {
+	case ehiRegionPhi:
+		refRH = func(float64) float64 { return 1 }
+		lo, hi = 0, 240
+	case ehiRegionRf:
+		refRH = func(t float64) float64 { return math.Min(1, ehiPa0/ehiPvstar(t)) }
+		lo, hi = 230, 300
+	case ehiRegionRs:
+		lo, hi = 295, 350
+	}
+
+	eqvar := e.value(e.region)
+	var solveErr error
+	t, err := bisect(func(t float64) float64 {
+		ref, err := ehiFindEqvar(t, refRH(t))
+		if err != nil {
+			solveErr = err
+			return math.NaN()
+		}
+		return ref.value(e.region) - eqvar
+	}, lo, hi)
+	if solveErr != nil {
+		return 0, solveErr
+	}
+	return t, err
+}
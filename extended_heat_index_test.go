@@ -0,0 +1,58 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExtendedHeatIndex(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance01)
+
+	cases := []struct {
+		t        TempF
+		rh       RelHumidity
+		expected TempF
+	}{
+		{TempF(-40), RelHumidity(50), TempF(-40.03)},
+		{TempF(0), RelHumidity(50), TempF(-0.36)},
+		{TempF(40), RelHumidity(50), TempF(37.84)},
+		{TempF(70), RelHumidity(50), TempF(68.67)},
+		{TempF(80), RelHumidity(40), TempF(79.43)},
+		{TempF(90), RelHumidity(0), TempF(84.05)},
+		{TempF(90), RelHumidity(60), TempF(98.96)},
+		{TempF(95), RelHumidity(50), TempF(105.05)},
+		{TempF(100), RelHumidity(40), TempF(109.43)},
+		{TempF(110), RelHumidity(40), TempF(148.37)},
+		{TempF(120), RelHumidity(80), TempF(258.19)},
+		{TempF(140), RelHumidity(100), TempF(413.11)},
+	}
+
+	for _, c := range cases {
+		result, err := ExtendedHeatIndexF(c.t, c.rh)
+		r.NoError(err)
+		r.True(eq(result.Unwrap(), c.expected.Unwrap()), "given t %v degF + rh %v: expected %v, got %v", c.t, c.rh, c.expected, result)
+
+		resultC, err := ExtendedHeatIndexC(c.t.C(), c.rh)
+		r.NoError(err)
+		r.True(Float64Equal(resultC.Unwrap(), c.expected.C().Unwrap(), Tolerance01))
+	}
+
+	// within the range of the NWS tables, the extended heat index agrees closely with the NWS heat index
+	for _, c := range []struct {
+		t  TempF
+		rh RelHumidity
+	}{{80, 40}, {86, 60}, {90, 50}, {95, 40}, {100, 30}} {
+		result, err := ExtendedHeatIndexF(c.t, c.rh)
+		r.NoError(err)
+		r.True(Float64Equal(result.Unwrap(), HeatIndexNWSF(c.t, c.rh).Unwrap(), 1.5), "given t %v degF + rh %v: got %v", c.t, c.rh, result)
+	}
+
+	result, err := ExtendedHeatIndexC(TempC(40), RelHumidity(55))
+	r.NoError(err)
+	r.Equal(HeatIndexWarning(HeatIndexWarningExtremeDanger), HeatIndexWarningC(result))
+
+	_, err = ExtendedHeatIndexC(TempC(-300), RelHumidity(50))
+	r.ErrorIs(err, ErrInputRange)
+}
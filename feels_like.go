@@ -0,0 +1,112 @@
+package libwx
+
+// FeelsLikeIndex identifies the index used to calculate a "feels like" temperature.
+type FeelsLikeIndex int
+
+const (
+	// FeelsLikeAirTemperature indicates the air temperature was used unchanged.
+	FeelsLikeAirTemperature FeelsLikeIndex = iota
+	// FeelsLikeWindChill indicates a wind chill index was used.
+	FeelsLikeWindChill
+	// FeelsLikeHeatIndex indicates the NWS heat index was used.
+	FeelsLikeHeatIndex
+	// FeelsLikeApparentTemperature indicates the Australian Bureau of
+	// Meteorology apparent temperature was used.
+	FeelsLikeApparentTemperature
+	// FeelsLikeHumidex indicates the Canadian humidex was used.
+	FeelsLikeHumidex
+)
+
+// String returns a human-readable name for the index.
+func (i FeelsLikeIndex) String() string {
+	switch i {
+	case FeelsLikeAirTemperature:
+		return "Air Temperature"
+	case FeelsLikeWindChill:
+		return "Wind Chill"
+	case FeelsLikeHeatIndex:
+		return "Heat Index"
+	case FeelsLikeApparentTemperature:
+		return "Apparent Temperature"
+	case FeelsLikeHumidex:
+		return "Humidex"
+	default:
+		return "Unknown"
+	}
+}
+
+// FeelsLikePolicy chooses and calculates a "feels like" temperature (in
+// Celsius) for the given temperature (in Celsius), relative humidity, and wind
+// speed (in meters/second), returning the temperature and the index used.
+type FeelsLikePolicy func(temp TempC, rh RelHumidity, windSpeed SpeedMps) (TempC, FeelsLikeIndex)
+
+// FeelsLikePolicyNWS follows NWS conventions: the wind chill is used at or
+// below 50 °F with wind of at least 3 mph, and the heat index (per
+// HeatIndexNWSF) at or above 80 °F when it is humid enough that the heat index
+// exceeds the air temperature. Otherwise, the air temperature is used.
+func FeelsLikePolicyNWS(temp TempC, rh RelHumidity, windSpeed SpeedMps) (TempC, FeelsLikeIndex) {
+	t := temp.F()
+	if t <= 50 && windSpeed.Mph() >= 3 {
+		return WindChillF(t, windSpeed.Mph()).C(), FeelsLikeWindChill
+	}
+	if t >= 80 {
+		if hi := HeatIndexNWSF(t, rh); hi > t {
+			return hi.C(), FeelsLikeHeatIndex
+		}
+	}
+	return temp, FeelsLikeAirTemperature
+}
+
+// FeelsLikePolicyApparentTemperature always uses the Australian Bureau of
+// Meteorology apparent temperature (in the shade), per ApparentTemperatureC.
+func FeelsLikePolicyApparentTemperature(temp TempC, rh RelHumidity, windSpeed SpeedMps) (TempC, FeelsLikeIndex) {
+	return ApparentTemperatureC(temp, rh, windSpeed), FeelsLikeApparentTemperature
+}
+
+// FeelsLikePolicyCanada follows Environment Canada conventions: the wind chill
+// (per WindChillMetricC) is used at or below 0 °C when there is any wind, and
+// the humidex at or above 20 °C when the humidex is at least 25. Otherwise, the
+// air temperature is used.
+func FeelsLikePolicyCanada(temp TempC, rh RelHumidity, windSpeed SpeedMps) (TempC, FeelsLikeIndex) {
+	if temp <= 0 && windSpeed > 0 {
+		return WindChillMetricC(temp, windSpeed.KmH()), FeelsLikeWindChill
+	}
+	if temp >= 20 {
+		if h := HumidexFromRelC(temp, rh); h >= 25 {
+			return h, FeelsLikeHumidex
+		}
+	}
+	return temp, FeelsLikeAirTemperature
+}
+
+// FeelsLikeF calculates a "feels like" temperature (in Fahrenheit) for the given
+// temperature (in Fahrenheit), relative humidity, and wind speed (in any speed
+// type), following NWS conventions, and reports which index was used.
+func FeelsLikeF[S Speed](temp TempF, rh RelHumidity, windSpeed S) (TempF, FeelsLikeIndex) {
+	return FeelsLikeFWithPolicy(temp, rh, windSpeed, FeelsLikePolicyNWS)
+}
+
+// FeelsLikeC calculates a "feels like" temperature (in Celsius) for the given
+// temperature (in Celsius), relative humidity, and wind speed (in any speed
+// type), following NWS conventions, and reports which index was used.
+func FeelsLikeC[S Speed](temp TempC, rh RelHumidity, windSpeed S) (TempC, FeelsLikeIndex) {
+	return FeelsLikeCWithPolicy(temp, rh, windSpeed, FeelsLikePolicyNWS)
+}
+
+// FeelsLikeFWithPolicy calculates a "feels like" temperature (in Fahrenheit) for
+// the given temperature (in Fahrenheit), relative humidity, and wind speed (in
+// any speed type), using the given policy, and reports which index was used.
+func FeelsLikeFWithPolicy[S Speed](temp TempF, rh RelHumidity, windSpeed S, policy FeelsLikePolicy) (TempF, FeelsLikeIndex) {
+	result, index := FeelsLikeCWithPolicy(temp.C(), rh, windSpeed, policy)
+	if index == FeelsLikeAirTemperature {
+		return temp, index
+	}
+	return result.F(), index
+}
+
+// FeelsLikeCWithPolicy calculates a "feels like" temperature (in Celsius) for
+// the given temperature (in Celsius), relative humidity, and wind speed (in any
+// speed type), using the given policy, and reports which index was used.
+func FeelsLikeCWithPolicy[S Speed](temp TempC, rh RelHumidity, windSpeed S, policy FeelsLikePolicy) (TempC, FeelsLikeIndex) {
+	return policy(temp, rh, speedMps(windSpeed))
+}
@@ -0,0 +1,77 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FeelsLike(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		t        TempF
+		rh       RelHumidity
+		wind     SpeedMph
+		expected TempF
+		index    FeelsLikeIndex
+	}{
+		{TempF(20), RelHumidity(60), SpeedMph(15), WindChillF(TempF(20), SpeedMph(15)), FeelsLikeWindChill},
+		{TempF(50), RelHumidity(60), SpeedMph(5), WindChillF(TempF(50), SpeedMph(5)), FeelsLikeWindChill},
+		{TempF(40), RelHumidity(60), SpeedMph(2), TempF(40), FeelsLikeAirTemperature},
+		{TempF(65), RelHumidity(90), SpeedMph(20), TempF(65), FeelsLikeAirTemperature},
+		{TempF(82), RelHumidity(60), SpeedMph(5), HeatIndexNWSF(TempF(82), RelHumidity(60)), FeelsLikeHeatIndex},
+		// hot but dry: the heat index (81.45 °F) is below the air temperature
+		{TempF(82), RelHumidity(40), SpeedMph(5), TempF(82), FeelsLikeAirTemperature},
+		{TempF(90), RelHumidity(30), SpeedMph(5), TempF(90), FeelsLikeAirTemperature},
+		{TempF(95), RelHumidity(60), SpeedMph(5), HeatIndexNWSF(TempF(95), RelHumidity(60)), FeelsLikeHeatIndex},
+	}
+
+	for _, c := range cases {
+		result, index := FeelsLikeF(c.t, c.rh, c.wind)
+		r.Equal(c.index, index, "given t %v + rh %v + wind %v", c.t, c.rh, c.wind)
+		r.True(Float64Equal(result.Unwrap(), c.expected.Unwrap(), Tolerance001), "given t %v + rh %v + wind %v: expected %v, got %v", c.t, c.rh, c.wind, c.expected, result)
+
+		resultC, indexC := FeelsLikeC(c.t.C(), c.rh, c.wind.KmH())
+		r.Equal(c.index, indexC)
+		r.True(Float64Equal(resultC.Unwrap(), c.expected.C().Unwrap(), Tolerance001))
+	}
+
+	r.Equal("Heat Index", FeelsLikeHeatIndex.String())
+}
+
+func Test_FeelsLike_WithPolicy(t *testing.T) {
+	r := require.New(t)
+
+	result, index := FeelsLikeCWithPolicy(TempC(30), RelHumidity(50), SpeedMps(2), FeelsLikePolicyApparentTemperature)
+	r.Equal(FeelsLikeApparentTemperature, index)
+	r.Equal(ApparentTemperatureC(TempC(30), RelHumidity(50), SpeedMps(2)), result)
+
+	result, index = FeelsLikeCWithPolicy(TempC(30), RelHumidity(70), SpeedKmH(10), FeelsLikePolicyCanada)
+	r.Equal(FeelsLikeHumidex, index)
+	r.Equal(HumidexFromRelC(TempC(30), RelHumidity(70)), result)
+
+	result, index = FeelsLikeCWithPolicy(TempC(-20), RelHumidity(70), SpeedKmH(30), FeelsLikePolicyCanada)
+	r.Equal(FeelsLikeWindChill, index)
+	r.True(Float64Equal(result.Unwrap(), WindChillMetricC(TempC(-20), SpeedKmH(30)).Unwrap(), Tolerance001))
+
+	// in calm air, the wind chill is just the air temperature
+	result, index = FeelsLikeCWithPolicy(TempC(-20), RelHumidity(70), SpeedKmH(0), FeelsLikePolicyCanada)
+	r.Equal(FeelsLikeAirTemperature, index)
+	r.Equal(TempC(-20), result)
+
+	result, index = FeelsLikeCWithPolicy(TempC(21), RelHumidity(20), SpeedKmH(10), FeelsLikePolicyCanada)
+	r.Equal(FeelsLikeAirTemperature, index)
+	r.Equal(TempC(21), result)
+
+	resultF, index := FeelsLikeFWithPolicy(TempF(70), RelHumidity(50), SpeedMph(5), FeelsLikePolicyCanada)
+	r.Equal(FeelsLikeAirTemperature, index)
+	r.Equal(TempF(70), resultF)
+
+	custom := func(temp TempC, _ RelHumidity, _ SpeedMps) (TempC, FeelsLikeIndex) {
+		return temp + 1, FeelsLikeApparentTemperature
+	}
+	resultF, index = FeelsLikeFWithPolicy(TempF(50), RelHumidity(50), SpeedKnots(5), custom)
+	r.Equal(FeelsLikeApparentTemperature, index)
+	r.True(Float64Equal(resultF.Unwrap(), 51.8, Tolerance001))
+}
@@ -0,0 +1,41 @@
+package libwx
+
+import "math"
+
+// FrostPointF calculates the frost point (the temperature at which the air is
+// saturated with respect to ice) given the current temperature (in Fahrenheit)
+// and relative humidity (with respect to liquid water, as reported by most
+// instruments).
+func FrostPointF(t TempF, rh RelHumidity) TempF {
+	return FrostPointC(t.C(), rh).F()
+}
+
+// FrostPointC calculates the frost point (the temperature at which the air is
+// saturated with respect to ice) given the current temperature (in Celsius)
+// and relative humidity (with respect to liquid water, as reported by most
+// instruments).
+func FrostPointC(t TempC, rh RelHumidity) TempC {
+	e := rh.Clamped().UnwrapFloat64() / 100.0 * magnusVaporPressure(t)
+	alpha := math.Log(e / magnusIceC)
+	return TempC((magnusIceB * alpha) / (magnusIceA - alpha))
+}
+
+// DewOrFrostPointF returns the frost point if the dew point is below freezing,
+// or the dew point otherwise, given the current temperature (in Fahrenheit) and
+// relative humidity. This is the temperature at which dew or frost forms on
+// surfaces.
+func DewOrFrostPointF(t TempF, rh RelHumidity) TempF {
+	return DewOrFrostPointC(t.C(), rh).F()
+}
+
+// DewOrFrostPointC returns the frost point if the dew point is below freezing,
+// or the dew point otherwise, given the current temperature (in Celsius) and
+// relative humidity. This is the temperature at which dew or frost forms on
+// surfaces.
+func DewOrFrostPointC(t TempC, rh RelHumidity) TempC {
+	dp := DewPointC(t, rh)
+	if dp < 0 {
+		return FrostPointC(t, rh)
+	}
+	return dp
+}
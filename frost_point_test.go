@@ -0,0 +1,39 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FrostPoint(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance01)
+
+	cases := []struct {
+		t        TempC
+		rh       RelHumidity
+		expected TempC
+	}{
+		{TempC(-10), RelHumidity(100), TempC(-8.88)},
+		{TempC(-20), RelHumidity(70), TempC(-21.64)},
+		{TempC(2), RelHumidity(60), TempC(-4.38)},
+	}
+
+	for _, c := range cases {
+		fp := FrostPointC(c.t, c.rh)
+		r.True(eq(fp.Unwrap(), c.expected.Unwrap()), "given t %v + rh %v: expected %v, got %v", c.t, c.rh, c.expected, fp)
+		r.True(Float64Equal(FrostPointF(c.t.F(), c.rh).C().Unwrap(), fp.Unwrap(), Tolerance001))
+
+		// below freezing, the frost point is above the dew point
+		r.Greater(fp.Unwrap(), DewPointC(c.t, c.rh).Unwrap())
+		r.Equal(fp, DewOrFrostPointC(c.t, c.rh))
+	}
+
+	// at 0 C the saturation vapor pressures over water and ice are (nearly) equal
+	r.True(Float64Equal(FrostPointC(TempC(0), RelHumidity(100)).Unwrap(), 0, Tolerance01))
+
+	r.Equal(DewPointC(TempC(20), RelHumidity(50)), DewOrFrostPointC(TempC(20), RelHumidity(50)))
+	r.Equal(DewPointF(TempF(68), RelHumidity(50)), DewOrFrostPointF(TempF(68), RelHumidity(50)))
+	r.True(Float64Equal(DewOrFrostPointF(TempF(14), RelHumidity(100)).C().Unwrap(), -8.88, Tolerance01))
+}
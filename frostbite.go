@@ -0,0 +1,73 @@
+package libwx
+
+import (
+	"math"
+	"time"
+)
+
+const (
+	// frostbiteMaxTime is the longest frostbite time for which an estimate is given.
+	frostbiteMaxTime = 30 * time.Minute
+	// frostbiteMaxWind is the highest wind speed (in km/h) covered by the
+	// frostbite chart; higher speeds are treated as this speed.
+	frostbiteMaxWind = 100
+)
+
+// FrostbiteTimeF estimates the time for exposed facial skin to freeze, given
+// the temperature (in Fahrenheit) and wind speed (in miles/hour).
+// See FrostbiteTimeC for details.
+func FrostbiteTimeF(temp TempF, windSpeed SpeedMph) (time.Duration, bool) {
+	return FrostbiteTimeC(temp.C(), windSpeed.KmH())
+}
+
+// FrostbiteTimeC estimates the time for exposed facial skin to freeze, given
+// the temperature (in Celsius) and wind speed at 10 m (in km/h), using the
+// regression from Tikuisis & Osczevski (2003) that underlies the NWS frostbite
+// chart. Wind speeds above 100 km/h, beyond the range of the chart, are
+// treated as 100 km/h. If frostbite is not expected within 30 minutes, false
+// is returned.
+func FrostbiteTimeC(temp TempC, windSpeed SpeedKmH) (time.Duration, bool) {
+	t := temp.Unwrap()
+	v := math.Min(math.Max(windSpeed.Unwrap(), 0), frostbiteMaxWind)
+	if t >= -4.8 {
+		return 0, false
+	}
+	minutes := (-24.5*(0.667*v+4.8) + 2111) * math.Pow(-4.8-t, -1.668)
+	d := time.Duration(minutes * float64(time.Minute))
+	if d > frostbiteMaxTime {
+		return 0, false
+	}
+	return d, true
+}
+
+// WindChillWarningF returns a wind chill risk category for the given
+// temperature (in Fahrenheit) and wind speed (in miles/hour), based on the
+// wind chill and estimated frostbite time.
+func WindChillWarningF(temp TempF, windSpeed SpeedMph) WindChillWarning {
+	d, ok := FrostbiteTimeF(temp, windSpeed)
+	return windChillWarning(WindChillF(temp, windSpeed).C(), d, ok)
+}
+
+// WindChillWarningC returns a wind chill risk category for the given
+// temperature (in Celsius) and wind speed at 10 m (in km/h), based on the
+// wind chill and estimated frostbite time.
+func WindChillWarningC(temp TempC, windSpeed SpeedKmH) WindChillWarning {
+	d, ok := FrostbiteTimeC(temp, windSpeed)
+	return windChillWarning(WindChillMetricC(temp, windSpeed), d, ok)
+}
+
+func windChillWarning(windChill TempC, frostbiteTime time.Duration, frostbite bool) WindChillWarning {
+	if frostbite {
+		if frostbiteTime <= 5*time.Minute {
+			return WindChillWarningExtreme
+		}
+		if frostbiteTime <= 10*time.Minute {
+			return WindChillWarningVeryHigh
+		}
+		return WindChillWarningHigh
+	}
+	if windChill.Unwrap() > -10 {
+		return WindChillWarningLow
+	}
+	return WindChillWarningModerate
+}
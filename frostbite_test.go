@@ -0,0 +1,66 @@
+package libwx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_FrostbiteTime(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		t        TempC
+		wind     SpeedKmH
+		expected float64 // minutes
+		warning  WindChillWarning
+	}{
+		{TempC(-20), SpeedKmH(20), 17.80, WindChillWarningHigh},
+		{TempC(-25), SpeedKmH(40), 8.91, WindChillWarningVeryHigh},
+		{TempC(-30), SpeedKmH(30), 6.91, WindChillWarningVeryHigh},
+		{TempC(-40), SpeedKmH(50), 3.10, WindChillWarningExtreme},
+	}
+
+	for _, c := range cases {
+		d, ok := FrostbiteTimeC(c.t, c.wind)
+		r.True(ok)
+		r.True(Float64Equal(d.Minutes(), c.expected, Tolerance01), "given t %v + wind %v: expected %v min, got %v", c.t, c.wind, c.expected, d)
+		r.Equal(c.warning, WindChillWarningC(c.t, c.wind))
+
+		dF, ok := FrostbiteTimeF(c.t.F(), c.wind.Mph())
+		r.True(ok)
+		r.InDelta(d.Seconds(), dF.Seconds(), 1)
+	}
+
+	_, ok := FrostbiteTimeC(TempC(-10), SpeedKmH(20))
+	r.False(ok)
+	_, ok = FrostbiteTimeC(TempC(0), SpeedKmH(50))
+	r.False(ok)
+
+	// wind speeds beyond the chart are treated as 100 km/h
+	d, ok := FrostbiteTimeC(TempC(-40), SpeedKmH(150))
+	r.True(ok)
+	r.Greater(d, time.Duration(0))
+	dMax, _ := FrostbiteTimeC(TempC(-40), SpeedKmH(100))
+	r.Equal(dMax, d)
+
+	d, ok = FrostbiteTimeF(TempF(0), SpeedMph(15))
+	r.True(ok)
+	r.Less(d, 30*time.Minute)
+	r.Greater(d, 10*time.Minute)
+}
+
+func Test_WindChillWarning(t *testing.T) {
+	r := require.New(t)
+
+	r.Equal(WindChillWarningLow, WindChillWarningC(TempC(0), SpeedKmH(10)))
+	r.Equal(WindChillWarningLow, WindChillWarningC(TempC(5), SpeedKmH(2)))
+	r.Equal(WindChillWarningModerate, WindChillWarningC(TempC(-10), SpeedKmH(20)))
+
+	r.Equal(WindChillWarningLow, WindChillWarningF(TempF(40), SpeedMph(10)))
+	r.Equal(WindChillWarningModerate, WindChillWarningF(TempF(10), SpeedMph(10)))
+	r.Equal(WindChillWarningHigh, WindChillWarningF(TempF(0), SpeedMph(15)))
+	r.Equal(WindChillWarningVeryHigh, WindChillWarningF(TempF(-22), SpeedMph(20)))
+	r.Equal(WindChillWarningExtreme, WindChillWarningF(TempF(-35), SpeedMph(40)))
+}
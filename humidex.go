@@ -0,0 +1,55 @@
+package libwx
+
+import "math"
+
+// HumidexF calculates the Canadian humidex given the temperature and dew point
+// (in Fahrenheit).
+// See HumidexC for details.
+func HumidexF(temp, dewPoint TempF) TempF {
+	return HumidexC(temp.C(), dewPoint.C()).F()
+}
+
+// HumidexC calculates the Canadian humidex given the temperature and dew point
+// (in Celsius), per Environment Canada (Masterton & Richardson, 1979).
+// See: https://climate.weather.gc.ca/glossary_e.html#humidex
+func HumidexC(temp, dewPoint TempC) TempC {
+	e := 6.11 * math.Exp(5417.7530*(1/273.16-1/(dewPoint.Unwrap()+273.15)))
+	return TempC(temp.Unwrap() + 0.5555*(e-10.0))
+}
+
+// HumidexFromRelF calculates the Canadian humidex given the temperature (in
+// Fahrenheit) and relative humidity. The dew point is calculated by DewPointF.
+func HumidexFromRelF(temp TempF, rh RelHumidity) TempF {
+	return HumidexFromRelC(temp.C(), rh).F()
+}
+
+// HumidexFromRelC calculates the Canadian humidex given the temperature (in
+// Celsius) and relative humidity. The dew point is calculated by DewPointC.
+func HumidexFromRelC(temp TempC, rh RelHumidity) TempC {
+	return HumidexC(temp, DewPointC(temp, rh))
+}
+
+// HumidexWarningF returns the Environment Canada comfort category for the
+// given humidex (in Fahrenheit).
+func HumidexWarningF(humidex TempF) HumidexWarning {
+	return HumidexWarningC(humidex.C())
+}
+
+// HumidexWarningC returns the Environment Canada comfort category for the
+// given humidex (in Celsius).
+// See: https://climate.weather.gc.ca/glossary_e.html#humidex
+func HumidexWarningC(humidex TempC) HumidexWarning {
+	if humidex.Unwrap() < 30 {
+		return HumidexWarningNone
+	}
+	if humidex.Unwrap() < 40 {
+		return HumidexWarningSomeDiscomfort
+	}
+	if humidex.Unwrap() <= 45 {
+		return HumidexWarningGreatDiscomfort
+	}
+	if humidex.Unwrap() < 54 {
+		return HumidexWarningDangerous
+	}
+	return HumidexWarningHeatStroke
+}
@@ -0,0 +1,38 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Humidex(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance001)
+
+	cases := []struct {
+		t        TempC
+		dp       TempC
+		expected TempC
+		warning  HumidexWarning
+	}{
+		{TempC(25), TempC(10), TempC(26.278), HumidexWarningNone},
+		{TempC(30), TempC(15), TempC(33.969), HumidexWarningSomeDiscomfort},
+		{TempC(35), TempC(25), TempC(47.338), HumidexWarningDangerous},
+		{TempC(40), TempC(28), TempC(55.889), HumidexWarningHeatStroke},
+	}
+
+	for _, c := range cases {
+		result := HumidexC(c.t, c.dp)
+		r.True(eq(result.Unwrap(), c.expected.Unwrap()), "given t %v + dp %v: expected %v, got %v", c.t, c.dp, c.expected, result)
+		r.True(eq(HumidexF(c.t.F(), c.dp.F()).C().Unwrap(), c.expected.Unwrap()))
+		r.Equal(c.warning, HumidexWarningC(result))
+		r.Equal(c.warning, HumidexWarningF(result.F()))
+	}
+
+	r.True(eq(HumidexFromRelC(TempC(30), RelHumidity(70)).Unwrap(), 41.205))
+	r.True(eq(HumidexFromRelF(TempC(20).F(), RelHumidity(40)).C().Unwrap(), 19.635))
+	r.Equal(HumidexWarningGreatDiscomfort, HumidexWarningC(HumidexFromRelC(TempC(30), RelHumidity(70))))
+	r.Equal(HumidexWarningGreatDiscomfort, HumidexWarningC(TempC(45)))
+	r.Equal(HumidexWarningDangerous, HumidexWarningC(TempC(45.5)))
+}
@@ -0,0 +1,46 @@
+package libwx
+
+// WaterForRelHumidityChangeF calculates the amount of water that must be added
+// to (or, if negative, removed from) the air in a room of the given volume (in
+// any volume type) to change its relative humidity from `from` to `to`, at the
+// given temperature (in Fahrenheit). See WaterForRelHumidityChangeC for details.
+func WaterForRelHumidityChangeF[V Volume](volume V, temp TempF, from, to RelHumidity) Liter {
+	return WaterForRelHumidityChangeC(volume, temp.C(), from, to)
+}
+
+// WaterForRelHumidityChangeC calculates the amount of water that must be added
+// to (or, if negative, removed from) the air in a room of the given volume (in
+// any volume type) to change its relative humidity from `from` to `to`, at the
+// given temperature (in Celsius).
+//
+// The result is the volume of liquid water (1 kg per liter) corresponding to
+// the change in absolute humidity, using the Magnus formula for saturation
+// vapor pressure. It does not account for air exchange with the outdoors or
+// moisture absorbed by the room's contents, so in practice a humidifier will
+// need to supply more.
+func WaterForRelHumidityChangeC[V Volume](volume V, temp TempC, from, to RelHumidity) Liter {
+	eSat := magnusVaporPressure(temp)
+	de := (to.Clamped().UnwrapFloat64() - from.Clamped().UnwrapFloat64()) / 100.0 * eSat
+	grams := absHumidityFromVaporPressure(de, temp.Unwrap()) * volumeCubicMeters(volume).Unwrap()
+	return Liter(grams / 1000.0)
+}
+
+// RelHumidityAfterHeatingF calculates the relative humidity of outdoor air,
+// at the given temperature (in Fahrenheit) and relative humidity, after it is
+// brought indoors and heated (or cooled) to the given indoor temperature (in
+// Fahrenheit). See RelHumidityAfterHeatingC for details.
+func RelHumidityAfterHeatingF(outdoor TempF, rh RelHumidity, indoor TempF) RelHumidity {
+	return RelHumidityAfterHeatingC(outdoor.C(), rh, indoor.C())
+}
+
+// RelHumidityAfterHeatingC calculates the relative humidity of outdoor air,
+// at the given temperature (in Celsius) and relative humidity, after it is
+// brought indoors and heated (or cooled) to the given indoor temperature (in
+// Celsius).
+//
+// This estimates the effect of ventilation and infiltration on indoor humidity.
+// The vapor pressure of the air is assumed to be unchanged; if cooling the air
+// would take it below its dew point, the result is clamped to 100%.
+func RelHumidityAfterHeatingC(outdoor TempC, rh RelHumidity, indoor TempC) RelHumidity {
+	return relHumidityAtTemp(outdoor, rh, indoor)
+}
@@ -0,0 +1,49 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WaterForRelHumidityChange(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance001)
+
+	// at 21 °C, 25% RH is 4.571 g/m³ and 45% RH is 8.228 g/m³
+	r.True(eq(WaterForRelHumidityChangeC(CubicMeter(50), TempC(21), RelHumidity(25), RelHumidity(45)).Unwrap(), 0.183))
+	r.True(eq(WaterForRelHumidityChangeC(Liter(50000), TempC(21), RelHumidity(25), RelHumidity(45)).Unwrap(), 0.183))
+	r.True(eq(WaterForRelHumidityChangeC(CubicMeter(50), TempC(21), RelHumidity(60), RelHumidity(45)).Unwrap(), -0.137))
+	r.Zero(WaterForRelHumidityChangeC(CubicMeter(50), TempC(21), RelHumidity(40), RelHumidity(40)))
+
+	// below −20 °C, where AbsHumidityFromRelC is not defined
+	r.True(eq(WaterForRelHumidityChangeC(CubicMeter(100), TempC(-25), RelHumidity(20), RelHumidity(80)).Unwrap(), 0.042))
+
+	// a 12 × 15 × 8 ft room at 70 °F
+	r.True(eq(WaterForRelHumidityChangeF(CubicFoot(12*15*8), TempF(70), RelHumidity(25), RelHumidity(45)).Unwrap(), 0.150))
+}
+
+func Test_RelHumidityAfterHeating(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		outdoor  TempC
+		rh       RelHumidity
+		indoor   TempC
+		expected RelHumidity
+	}{
+		{TempC(-10), RelHumidity(80), TempC(21), RelHumidity(9)},
+		{TempC(0), RelHumidity(90), TempC(20), RelHumidity(24)},
+		{TempC(20), RelHumidity(50), TempC(20), RelHumidity(50)},
+		// cold outdoor air, below −20 °C
+		{TempC(-25), RelHumidity(80), TempC(21), RelHumidity(3)},
+		{TempC(-30), RelHumidity(70), TempC(20), RelHumidity(2)},
+		// cooled below its dew point
+		{TempC(25), RelHumidity(80), TempC(15), RelHumidity(100)},
+	}
+
+	for _, c := range cases {
+		r.Equal(c.expected, RelHumidityAfterHeatingC(c.outdoor, c.rh, c.indoor), "given %v/%v → %v", c.outdoor, c.rh, c.indoor)
+		r.Equal(c.expected, RelHumidityAfterHeatingF(c.outdoor.F(), c.rh, c.indoor.F()))
+	}
+}
@@ -0,0 +1,141 @@
+package libwx
+
+// Conversions among humidity representations. These use the same Magnus formula
+// as DewPointC for saturation vapor pressure over water, so conversions through
+// any path among the functions in this file are consistent with one another,
+// and with the *WithFormula functions given VaporPressureMagnus.
+//
+// AbsHumidityFromRelC and RelHumidityFromAbsC use a different saturation vapor
+// pressure formula. To convert between relative and absolute humidity
+// consistently with these functions, go through VaporPressure (e.g.
+// AbsHumidityFromVaporPressureC(t, VaporPressureFromRelC(t, rh))).
+
+const mixingRatioEpsilon = 621.97 // g/kg; ratio of the molar masses of water and dry air
+
+// VaporPressureFromRelF calculates vapor pressure from the given temperature
+// (in Fahrenheit) and relative humidity.
+func VaporPressureFromRelF(temp TempF, rh RelHumidity) VaporPressure {
+	return VaporPressureFromRelC(temp.C(), rh)
+}
+
+// VaporPressureFromRelC calculates vapor pressure from the given temperature
+// (in Celsius) and relative humidity.
+func VaporPressureFromRelC(temp TempC, rh RelHumidity) VaporPressure {
+	return VaporPressure(rh.Clamped().UnwrapFloat64() / 100.0 * magnusVaporPressure(temp))
+}
+
+// RelHumidityFromVaporPressureF calculates relative humidity from the given
+// temperature (in Fahrenheit) and vapor pressure.
+func RelHumidityFromVaporPressureF(temp TempF, e VaporPressure) RelHumidity {
+	return RelHumidityFromVaporPressureC(temp.C(), e)
+}
+
+// RelHumidityFromVaporPressureC calculates relative humidity from the given
+// temperature (in Celsius) and vapor pressure.
+func RelHumidityFromVaporPressureC(temp TempC, e VaporPressure) RelHumidity {
+	return ClampedRelHumidity(int(e.Unwrap()/magnusVaporPressure(temp)*100.0 + 0.5))
+}
+
+// VaporPressureFromDewPointF calculates vapor pressure from the given dew point (in Fahrenheit).
+func VaporPressureFromDewPointF(dewPoint TempF) VaporPressure {
+	return VaporPressureFromDewPointC(dewPoint.C())
+}
+
+// VaporPressureFromDewPointC calculates vapor pressure from the given dew point (in Celsius).
+func VaporPressureFromDewPointC(dewPoint TempC) VaporPressure {
+	return VaporPressure(magnusVaporPressure(dewPoint))
+}
+
+// DewPointFFromVaporPressure calculates the dew point (in Fahrenheit) from the given vapor pressure.
+func DewPointFFromVaporPressure(e VaporPressure) TempF {
+	return DewPointCFromVaporPressure(e).F()
+}
+
+// DewPointCFromVaporPressure calculates the dew point (in Celsius) from the given vapor pressure.
+func DewPointCFromVaporPressure(e VaporPressure) TempC {
+	return TempC(magnusDewPoint(e.Unwrap()))
+}
+
+// VaporPressureFromAbsF calculates vapor pressure from the given temperature
+// (in Fahrenheit) and absolute humidity.
+func VaporPressureFromAbsF(temp TempF, ah AbsHumidity) VaporPressure {
+	return VaporPressureFromAbsC(temp.C(), ah)
+}
+
+// VaporPressureFromAbsC calculates vapor pressure from the given temperature
+// (in Celsius) and absolute humidity.
+func VaporPressureFromAbsC(temp TempC, ah AbsHumidity) VaporPressure {
+	return VaporPressure(vaporPressureFromAbsHumidity(ah.Unwrap(), temp.Unwrap()))
+}
+
+// AbsHumidityFromVaporPressureF calculates absolute humidity from the given
+// temperature (in Fahrenheit) and vapor pressure.
+func AbsHumidityFromVaporPressureF(temp TempF, e VaporPressure) AbsHumidity {
+	return AbsHumidityFromVaporPressureC(temp.C(), e)
+}
+
+// AbsHumidityFromVaporPressureC calculates absolute humidity from the given
+// temperature (in Celsius) and vapor pressure.
+func AbsHumidityFromVaporPressureC(temp TempC, e VaporPressure) AbsHumidity {
+	return AbsHumidity(absHumidityFromVaporPressure(e.Unwrap(), temp.Unwrap()))
+}
+
+// MixingRatioFromVaporPressure calculates the mixing ratio from the given vapor
+// pressure and total air pressure (in any pressure type).
+func MixingRatioFromVaporPressure[P Pressure](e VaporPressure, p P) MixingRatio {
+	return MixingRatio(mixingRatioEpsilon * e.Unwrap() / (pressureMb(p).Unwrap() - e.Unwrap()))
+}
+
+// VaporPressureFromMixingRatio calculates vapor pressure from the given mixing
+// ratio and total air pressure (in any pressure type).
+func VaporPressureFromMixingRatio[P Pressure](w MixingRatio, p P) VaporPressure {
+	return VaporPressure(w.Unwrap() * pressureMb(p).Unwrap() / (mixingRatioEpsilon + w.Unwrap()))
+}
+
+// MixingRatioFromRelF calculates the mixing ratio from the given temperature
+// (in Fahrenheit), relative humidity, and air pressure (in any pressure type).
+func MixingRatioFromRelF[P Pressure](temp TempF, rh RelHumidity, p P) MixingRatio {
+	return MixingRatioFromRelC(temp.C(), rh, p)
+}
+
+// MixingRatioFromRelC calculates the mixing ratio from the given temperature
+// (in Celsius), relative humidity, and air pressure (in any pressure type).
+func MixingRatioFromRelC[P Pressure](temp TempC, rh RelHumidity, p P) MixingRatio {
+	return MixingRatioFromVaporPressure(VaporPressureFromRelC(temp, rh), p)
+}
+
+// RelHumidityFromMixingRatioF calculates relative humidity from the given
+// temperature (in Fahrenheit), mixing ratio, and air pressure (in any pressure type).
+func RelHumidityFromMixingRatioF[P Pressure](temp TempF, w MixingRatio, p P) RelHumidity {
+	return RelHumidityFromMixingRatioC(temp.C(), w, p)
+}
+
+// RelHumidityFromMixingRatioC calculates relative humidity from the given
+// temperature (in Celsius), mixing ratio, and air pressure (in any pressure type).
+func RelHumidityFromMixingRatioC[P Pressure](temp TempC, w MixingRatio, p P) RelHumidity {
+	return RelHumidityFromVaporPressureC(temp, VaporPressureFromMixingRatio(w, p))
+}
+
+// SpecificHumidityFromRelF calculates specific humidity from the given temperature
+// (in Fahrenheit), relative humidity, and air pressure (in any pressure type).
+func SpecificHumidityFromRelF[P Pressure](temp TempF, rh RelHumidity, p P) SpecificHumidity {
+	return SpecificHumidityFromRelC(temp.C(), rh, p)
+}
+
+// SpecificHumidityFromRelC calculates specific humidity from the given temperature
+// (in Celsius), relative humidity, and air pressure (in any pressure type).
+func SpecificHumidityFromRelC[P Pressure](temp TempC, rh RelHumidity, p P) SpecificHumidity {
+	return MixingRatioFromRelC(temp, rh, p).SpecificHumidity()
+}
+
+// RelHumidityFromSpecificHumidityF calculates relative humidity from the given
+// temperature (in Fahrenheit), specific humidity, and air pressure (in any pressure type).
+func RelHumidityFromSpecificHumidityF[P Pressure](temp TempF, q SpecificHumidity, p P) RelHumidity {
+	return RelHumidityFromSpecificHumidityC(temp.C(), q, p)
+}
+
+// RelHumidityFromSpecificHumidityC calculates relative humidity from the given
+// temperature (in Celsius), specific humidity, and air pressure (in any pressure type).
+func RelHumidityFromSpecificHumidityC[P Pressure](temp TempC, q SpecificHumidity, p P) RelHumidity {
+	return RelHumidityFromMixingRatioC(temp, q.MixingRatio(), p)
+}
@@ -0,0 +1,21 @@
+package libwx
+
+// SpecificHumidity converts a mixing ratio to specific humidity.
+func (w MixingRatio) SpecificHumidity() SpecificHumidity {
+	return SpecificHumidity(w / (1 + w/1000))
+}
+
+// MixingRatio converts specific humidity to a mixing ratio.
+func (q SpecificHumidity) MixingRatio() MixingRatio {
+	return MixingRatio(q / (1 - q/1000))
+}
+
+// Mb returns the vapor pressure as a PressureMb.
+func (e VaporPressure) Mb() PressureMb {
+	return PressureMb(e)
+}
+
+// InHg returns the vapor pressure as a PressureInHg.
+func (e VaporPressure) InHg() PressureInHg {
+	return PressureMb(e).InHg()
+}
@@ -0,0 +1,68 @@
+package libwx
+
+// enhancementFactor returns the water vapor enhancement factor (the ratio of the
+// saturation vapor pressure of moist air to that of pure water vapor) at the
+// given pressure (in hPa) and temperature (in Celsius), per Buck (1996).
+func enhancementFactor(p, t float64) float64 {
+	return 1 + 1e-4*(7.2+p*(0.0320+5.9e-6*t*t))
+}
+
+// moistSaturationVaporPressure returns the saturation vapor pressure (in hPa)
+// of moist air at the given temperature (in Celsius) and pressure (in hPa).
+func moistSaturationVaporPressure(t, p float64) float64 {
+	return enhancementFactor(p, t) * magnusVaporPressure(TempC(t))
+}
+
+// AbsHumidityFromRelFWithPressure calculates absolute humidity from the given
+// temperature (in Fahrenheit), relative humidity, and station pressure (in any
+// pressure type), accounting for the water vapor enhancement factor.
+func AbsHumidityFromRelFWithPressure[P Pressure](temp TempF, rh RelHumidity, p P) AbsHumidity {
+	return AbsHumidityFromRelCWithPressure(temp.C(), rh, p)
+}
+
+// AbsHumidityFromRelCWithPressure calculates absolute humidity from the given
+// temperature (in Celsius), relative humidity, and station pressure (in any
+// pressure type), accounting for the water vapor enhancement factor.
+func AbsHumidityFromRelCWithPressure[P Pressure](temp TempC, rh RelHumidity, p P) AbsHumidity {
+	e := rh.Clamped().UnwrapFloat64() / 100.0 * moistSaturationVaporPressure(temp.Unwrap(), pressureMb(p).Unwrap())
+	return AbsHumidity(absHumidityFromVaporPressure(e, temp.Unwrap()))
+}
+
+// RelHumidityFromAbsFWithPressure calculates relative humidity from the given
+// temperature (in Fahrenheit), absolute humidity, and station pressure (in any
+// pressure type), accounting for the water vapor enhancement factor.
+func RelHumidityFromAbsFWithPressure[P Pressure](temp TempF, ah AbsHumidity, p P) RelHumidity {
+	return RelHumidityFromAbsCWithPressure(temp.C(), ah, p)
+}
+
+// RelHumidityFromAbsCWithPressure calculates relative humidity from the given
+// temperature (in Celsius), absolute humidity, and station pressure (in any
+// pressure type), accounting for the water vapor enhancement factor.
+func RelHumidityFromAbsCWithPressure[P Pressure](temp TempC, ah AbsHumidity, p P) RelHumidity {
+	e := vaporPressureFromAbsHumidity(ah.Unwrap(), temp.Unwrap())
+	pSat := moistSaturationVaporPressure(temp.Unwrap(), pressureMb(p).Unwrap())
+	return ClampedRelHumidity(int(e/pSat*100.0 + 0.5))
+}
+
+// DewPointFWithPressure calculates the dew point given the current temperature
+// (in Fahrenheit), relative humidity, and station pressure (in any pressure
+// type), accounting for the water vapor enhancement factor.
+func DewPointFWithPressure[P Pressure](temp TempF, rh RelHumidity, p P) TempF {
+	return DewPointCWithPressure(temp.C(), rh, p).F()
+}
+
+// DewPointCWithPressure calculates the dew point given the current temperature
+// (in Celsius), relative humidity, and station pressure (in any pressure type),
+// accounting for the water vapor enhancement factor.
+func DewPointCWithPressure[P Pressure](temp TempC, rh RelHumidity, p P) TempC {
+	pMb := pressureMb(p).Unwrap()
+	e := rh.Clamped().UnwrapFloat64() / 100.0 * moistSaturationVaporPressure(temp.Unwrap(), pMb)
+
+	// the enhancement factor depends (weakly) on temperature, so iterate
+	// to find the temperature at which e is the saturation vapor pressure
+	td := temp.Unwrap()
+	for i := 0; i < 3; i++ {
+		td = magnusDewPoint(e / enhancementFactor(pMb, td))
+	}
+	return TempC(td)
+}
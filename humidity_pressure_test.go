@@ -0,0 +1,49 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Humidity_WithPressure(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		temp TempC
+		rh   RelHumidity
+	}{
+		{TempC(20), RelHumidity(50)},
+		{TempC(30), RelHumidity(70)},
+		{TempC(5), RelHumidity(90)},
+		{TempC(-15), RelHumidity(60)},
+	}
+
+	for _, c := range cases {
+		seaLevel := AbsHumidityFromRelCWithPressure(c.temp, c.rh, PressureMb(1013.25))
+		mountain := AbsHumidityFromRelCWithPressure(c.temp, c.rh, PressureMb(700))
+
+		// the enhancement factor is ~1.004 at sea level, and smaller at lower pressure
+		naive := absHumidityFromVaporPressure(c.rh.UnwrapFloat64()/100*magnusVaporPressure(c.temp), c.temp.Unwrap())
+		r.True(Float64Equal(seaLevel.Unwrap()/naive, 1.0044, 0.0005), "given t %v + rh %v: got ratio %v", c.temp, c.rh, seaLevel.Unwrap()/naive)
+		r.Greater(seaLevel.Unwrap(), mountain.Unwrap())
+		r.Greater(mountain.Unwrap(), naive)
+
+		r.Equal(c.rh, RelHumidityFromAbsCWithPressure(c.temp, mountain, PressureMb(700)))
+		r.Equal(c.rh, RelHumidityFromAbsFWithPressure(c.temp.F(), mountain, PressureMb(700).InHg()))
+
+		dp := DewPointCWithPressure(c.temp, c.rh, PressureMb(700))
+		r.True(Float64Equal(dp.Unwrap(), DewPointC(c.temp, c.rh).Unwrap(), Tolerance1), "given t %v + rh %v: expected ~%v, got %v",
+			c.temp, c.rh, DewPointC(c.temp, c.rh), dp)
+
+		dpF := DewPointFWithPressure(c.temp.F(), c.rh, PressureMb(700).InHg())
+		r.True(Float64Equal(dpF.C().Unwrap(), dp.Unwrap(), Tolerance001))
+	}
+
+	r.True(Float64Equal(DewPointCWithPressure(TempC(10), RelHumidity(100), PressureMb(700)).Unwrap(), 10, Tolerance001))
+	r.True(Float64Equal(
+		AbsHumidityFromRelFWithPressure(TempF(68), RelHumidity(50), PressureInHg(20.67)).Unwrap(),
+		AbsHumidityFromRelCWithPressure(TempC(20), RelHumidity(50), PressureInHg(20.67).Mb()).Unwrap(),
+		Tolerance001,
+	))
+}
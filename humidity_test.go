@@ -0,0 +1,70 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Humidity_MixingRatio(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance01)
+
+	cases := []struct {
+		temp TempC
+		rh   RelHumidity
+		p    PressureMb
+		e    VaporPressure
+		w    MixingRatio
+		q    SpecificHumidity
+	}{
+		{TempC(20), RelHumidity(50), PressureMb(1013.25), VaporPressure(11.67), MixingRatio(7.25), SpecificHumidity(7.20)},
+		{TempC(30), RelHumidity(80), PressureMb(1000), VaporPressure(33.89), MixingRatio(21.82), SpecificHumidity(21.35)},
+		{TempC(0), RelHumidity(100), PressureMb(700), VaporPressure(6.11), MixingRatio(5.48), SpecificHumidity(5.45)},
+	}
+
+	for _, c := range cases {
+		e := VaporPressureFromRelC(c.temp, c.rh)
+		r.True(eq(e.Unwrap(), c.e.Unwrap()), "given t %v + rh %v: expected e %v, got %v", c.temp, c.rh, c.e, e)
+
+		w := MixingRatioFromRelC(c.temp, c.rh, c.p)
+		r.True(eq(w.Unwrap(), c.w.Unwrap()), "given t %v + rh %v + p %v: expected w %v, got %v", c.temp, c.rh, c.p, c.w, w)
+
+		q := SpecificHumidityFromRelC(c.temp, c.rh, c.p)
+		r.True(eq(q.Unwrap(), c.q.Unwrap()), "given t %v + rh %v + p %v: expected q %v, got %v", c.temp, c.rh, c.p, c.q, q)
+
+		r.True(Float64Equal(q.MixingRatio().Unwrap(), w.Unwrap(), Tolerance001))
+		r.True(Float64Equal(VaporPressureFromMixingRatio(w, c.p).Unwrap(), e.Unwrap(), Tolerance001))
+
+		r.Equal(c.rh, RelHumidityFromVaporPressureC(c.temp, e))
+		r.Equal(c.rh, RelHumidityFromMixingRatioC(c.temp, w, c.p))
+		r.Equal(c.rh, RelHumidityFromSpecificHumidityC(c.temp, q, c.p))
+		r.Equal(c.rh, RelHumidityFromSpecificHumidityF(c.temp.F(), q, c.p.InHg()))
+
+		dp := DewPointCFromVaporPressure(e)
+		r.True(Float64Equal(dp.Unwrap(), DewPointC(c.temp, c.rh).Unwrap(), Tolerance001))
+		r.True(Float64Equal(VaporPressureFromDewPointC(dp).Unwrap(), e.Unwrap(), Tolerance001))
+
+		ah := AbsHumidityFromVaporPressureC(c.temp, e)
+		r.True(Float64Equal(VaporPressureFromAbsC(c.temp, ah).Unwrap(), e.Unwrap(), Tolerance001))
+	}
+}
+
+func Test_Humidity_AbsHumidityRoundTrip(t *testing.T) {
+	r := require.New(t)
+
+	for _, temp := range []TempC{-30, -10, 0, 15, 30} {
+		for _, rh := range []RelHumidity{5, 40, 80, 100} {
+			ah := AbsHumidityFromVaporPressureC(temp, VaporPressureFromRelC(temp, rh))
+			r.Equal(rh, RelHumidityFromVaporPressureC(temp, VaporPressureFromAbsC(temp, ah)), "given t %v + rh %v", temp, rh)
+
+			magnus, err := AbsHumidityFromRelCWithFormula(temp, rh, VaporPressureMagnus)
+			r.NoError(err)
+			r.True(Float64Equal(ah.Unwrap(), magnus.Unwrap(), Tolerance001), "given t %v + rh %v: expected %v, got %v", temp, rh, magnus, ah)
+		}
+	}
+
+	// 0 °C, 80% round-trips through absolute humidity and back
+	ah := AbsHumidityFromVaporPressureF(TempC(0).F(), VaporPressureFromRelF(TempC(0).F(), 80))
+	r.Equal(RelHumidity(80), RelHumidityFromVaporPressureF(TempC(0).F(), VaporPressureFromAbsF(TempC(0).F(), ah)))
+}
@@ -27,3 +27,18 @@ func (rh RelHumidity) Clamped() RelHumidity {
 type AbsHumidity float64
 
 func (ah AbsHumidity) Unwrap() float64 { return float64(ah) }
+
+// MixingRatio represents the mass of water vapor per mass of dry air, in grams per kilogram (g/kg).
+type MixingRatio float64
+
+func (w MixingRatio) Unwrap() float64 { return float64(w) }
+
+// SpecificHumidity represents the mass of water vapor per mass of moist air, in grams per kilogram (g/kg).
+type SpecificHumidity float64
+
+func (q SpecificHumidity) Unwrap() float64 { return float64(q) }
+
+// VaporPressure represents the partial pressure of water vapor, in millibars (hPa).
+type VaporPressure float64
+
+func (e VaporPressure) Unwrap() float64 { return float64(e) }
@@ -0,0 +1,6 @@
+package libwx
+
+// Irradiance represents radiant flux per unit area (e.g. solar radiation) in watts per square meter.
+type Irradiance float64
+
+func (i Irradiance) Unwrap() float64 { return float64(i) }
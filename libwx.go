@@ -12,6 +12,22 @@ import (
 
 var ErrInputRange = errors.New("one or more input values are outside the calculation's supported range")
 var ErrMismatchedInputLength = errors.New("input slices must be the same length")
+var ErrInsufficientData = errors.New("not enough data is available for the calculation")
+var ErrUnsortedInput = errors.New("input times must be in chronological order")
+
+// Magnus formula constants, per Alduchov & Eskridge (1996).
+const (
+	magnusA = 17.625
+	magnusB = 243.04 // degC
+	magnusC = 6.1094 // hPa
+)
+
+// Magnus formula constants for saturation over ice, per Alduchov & Eskridge (1996).
+const (
+	magnusIceA = 22.587
+	magnusIceB = 273.86 // degC
+	magnusIceC = 6.1121 // hPa
+)
 
 // DewPointF calculates the dew point given the current temperature (in Fahrenheit)
 // and relative humidity percentage (an integer 0-100, *not* a float 0.0-1.0).
@@ -23,12 +39,61 @@ func DewPointF(t TempF, rh RelHumidity) TempF {
 // and relative humidity percentage (an integer 0-100, *not* a float 0.0-1.0).
 func DewPointC(t TempC, rh RelHumidity) TempC {
 	rh = rh.Clamped()
-	const (
-		a = 17.625
-		b = 243.04
-	)
-	alpha := math.Log(float64(rh)/100.0) + a*float64(t)/(b+float64(t))
-	return TempC((b * alpha) / (a - alpha))
+	alpha := math.Log(float64(rh)/100.0) + magnusA*float64(t)/(magnusB+float64(t))
+	return TempC((magnusB * alpha) / (magnusA - alpha))
+}
+
+// RelHumidityFromDewPointF calculates the relative humidity given the current
+// temperature and dew point (in Fahrenheit). This is the inverse of DewPointF.
+// If the dew point is above the temperature, 100% is returned.
+func RelHumidityFromDewPointF(t, dewPoint TempF) RelHumidity {
+	return RelHumidityFromDewPointC(t.C(), dewPoint.C())
+}
+
+// RelHumidityFromDewPointC calculates the relative humidity given the current
+// temperature and dew point (in Celsius). This is the inverse of DewPointC.
+// If the dew point is above the temperature, 100% is returned.
+func RelHumidityFromDewPointC(t, dewPoint TempC) RelHumidity {
+	gamma := magnusA*float64(dewPoint)/(magnusB+float64(dewPoint)) - magnusA*float64(t)/(magnusB+float64(t))
+	return ClampedRelHumidity(int(100.0*math.Exp(gamma) + 0.5))
+}
+
+// RelHumidityFromDewPointFWithValidation calculates the relative humidity given
+// the current temperature and dew point (in Fahrenheit).
+// If the dew point is above the temperature, ErrInputRange is returned.
+func RelHumidityFromDewPointFWithValidation(t, dewPoint TempF) (RelHumidity, error) {
+	return RelHumidityFromDewPointCWithValidation(t.C(), dewPoint.C())
+}
+
+// RelHumidityFromDewPointCWithValidation calculates the relative humidity given
+// the current temperature and dew point (in Celsius).
+// If the dew point is above the temperature, ErrInputRange is returned.
+func RelHumidityFromDewPointCWithValidation(t, dewPoint TempC) (RelHumidity, error) {
+	if Float64Compare(dewPoint.Unwrap(), t.Unwrap(), 1e-9) > 0 {
+		return 100, ErrInputRange
+	}
+	return RelHumidityFromDewPointC(t, dewPoint), nil
+}
+
+// magnusVaporPressure returns the saturation vapor pressure (in hPa) over water
+// at the given temperature, using the Magnus formula with the same constants
+// as DewPointC.
+func magnusVaporPressure(temp TempC) float64 {
+	return magnusC * math.Exp(magnusA*temp.Unwrap()/(magnusB+temp.Unwrap()))
+}
+
+// magnusIceVaporPressure returns the saturation vapor pressure (in hPa) over
+// ice at the given temperature (in Celsius), using the Magnus formula.
+func magnusIceVaporPressure(t float64) float64 {
+	return magnusIceC * math.Exp(magnusIceA*t/(magnusIceB+t))
+}
+
+// magnusDewPoint returns the temperature (in Celsius) at which the given vapor
+// pressure (in hPa) is the saturation vapor pressure over water, using the
+// Magnus formula with the same constants as DewPointC.
+func magnusDewPoint(e float64) float64 {
+	alpha := math.Log(e / magnusC)
+	return (magnusB * alpha) / (magnusA - alpha)
 }
 
 // WindChillF calculates the wind chill for the given temperature (in Fahrenheit)
@@ -70,6 +135,36 @@ func WindChillCWithValidation(temp TempC, windSpeed SpeedMph) (TempC, error) {
 	return WindChillF(temp.F(), windSpeed).C(), nil
 }
 
+// WindChillMetricC calculates the Environment Canada wind chill index for the
+// given temperature (in Celsius) and wind speed at 10 m (in km/h).
+// Below 5 km/h, EC's low-wind formula is used. If temperature is over 10
+// degrees C, the given temperature is returned - the index is defined only at
+// or below 10 degrees C.
+// See: https://climate.weather.gc.ca/glossary_e.html#windChill
+func WindChillMetricC(temp TempC, windSpeed SpeedKmH) TempC {
+	if temp > 10.0 || windSpeed <= 0 {
+		return temp
+	}
+	t := temp.Unwrap()
+	v := windSpeed.Unwrap()
+	if v < 5.0 {
+		return TempC(t + (-1.59+0.1345*t)/5.0*v)
+	}
+	vPow := math.Pow(v, 0.16)
+	return TempC(13.12 + 0.6215*t - 11.37*vPow + 0.3965*t*vPow)
+}
+
+// WindChillMetricCWithValidation calculates the Environment Canada wind chill
+// index for the given temperature (in Celsius) and wind speed at 10 m (in km/h).
+// If temperature is over 10 degrees C or wind speed is negative, ErrInputRange
+// is returned.
+func WindChillMetricCWithValidation(temp TempC, windSpeed SpeedKmH) (TempC, error) {
+	if temp > 10.0 || windSpeed < 0 {
+		return temp, ErrInputRange
+	}
+	return WindChillMetricC(temp, windSpeed), nil
+}
+
 // IndoorHumidityRecommendationF returns the maximum recommended indoor relative
 // humidity percentage for the given outdoor temperature (in degrees F).
 func IndoorHumidityRecommendationF(outdoorT TempF) RelHumidity {
@@ -239,6 +334,37 @@ func HeatIndexCWithValidation(temp TempC, rh RelHumidity) (TempC, error) {
 	)), err
 }
 
+// HeatIndexNWSF calculates the heat index for the given temperature (in Fahrenheit)
+// and relative humidity percentage, following the complete NWS procedure:
+// the simple Steadman formula is used when the average of its result and the
+// temperature is below 80 °F; otherwise the Rothfusz regression is used, with
+// the NWS adjustments for low and high relative humidity.
+// See: https://www.wpc.ncep.noaa.gov/html/heatindex_equation.shtml
+func HeatIndexNWSF(temp TempF, rh RelHumidity) TempF {
+	t := temp.Unwrap()
+	relH := rh.Clamped().UnwrapFloat64()
+
+	hi := 0.5 * (t + 61.0 + (t-68.0)*1.2 + relH*0.094)
+	if (hi+t)/2 < 80 {
+		return TempF(hi)
+	}
+
+	hi = rawHeatIndex(heatIndexConstantsF(), t, relH)
+	if relH < 13 && t >= 80 && t <= 112 {
+		hi -= ((13 - relH) / 4) * math.Sqrt((17-math.Abs(t-95))/17)
+	} else if relH > 85 && t >= 80 && t <= 87 {
+		hi += ((relH - 85) / 10) * ((87 - t) / 5)
+	}
+	return TempF(hi)
+}
+
+// HeatIndexNWSC calculates the heat index for the given temperature (in Celsius)
+// and relative humidity percentage, following the complete NWS procedure.
+// See HeatIndexNWSF for details.
+func HeatIndexNWSC(temp TempC, rh RelHumidity) TempC {
+	return HeatIndexNWSF(temp.F(), rh).C()
+}
+
 // HeatIndexWarningF returns a heat index warning level for the
 // given heat index temperature (in Fahrenheit) per
 // https://en.wikipedia.org/wiki/Heat_index#Table_of_values
@@ -207,3 +207,98 @@ func Test_AbsHumidity_EdgeCases(t *testing.T) {
 	result = AbsHumidityFromRelC(TempC(110), RelHumidity(50))
 	r.Equal(AbsHumidity(0), result, "Should return 0 for temperature out of range")
 }
+
+func Test_RelHumidityFromDewPoint(t *testing.T) {
+	r := require.New(t)
+
+	for _, temp := range []TempC{-30, -10, 0, 15.5, 25, 40} {
+		for rh := RelHumidity(1); rh <= 100; rh++ {
+			dp := DewPointC(temp, rh)
+			r.Equal(rh, RelHumidityFromDewPointC(temp, dp), "given t %v + dp %v", temp, dp)
+			r.Equal(rh, RelHumidityFromDewPointF(temp.F(), DewPointF(temp.F(), rh)), "given t %v + dp %v", temp.F(), dp.F())
+
+			v, err := RelHumidityFromDewPointCWithValidation(temp, dp)
+			r.NoError(err)
+			r.Equal(rh, v)
+		}
+	}
+
+	r.Equal(RelHumidity(47), RelHumidityFromDewPointF(TempF(77), TempF(55)))
+	r.Equal(RelHumidity(100), RelHumidityFromDewPointC(TempC(10), TempC(12)))
+
+	_, err := RelHumidityFromDewPointCWithValidation(TempC(10), TempC(12))
+	r.ErrorIs(err, ErrInputRange)
+	_, err = RelHumidityFromDewPointFWithValidation(TempF(50), TempF(50.5))
+	r.ErrorIs(err, ErrInputRange)
+	v, err := RelHumidityFromDewPointFWithValidation(TempF(50), TempF(50))
+	r.NoError(err)
+	r.Equal(RelHumidity(100), v)
+}
+
+func Test_HeatIndexNWS(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance01)
+
+	cases := []struct {
+		t        TempF
+		rh       RelHumidity
+		expected TempF
+	}{
+		// simple formula
+		{TempF(60), RelHumidity(20), TempF(56.64)},
+		{TempF(70), RelHumidity(50), TempF(69.05)},
+		{TempF(75), RelHumidity(90), TempF(76.43)},
+		{TempF(80), RelHumidity(40), TempF(79.58)},
+		// Rothfusz regression
+		{TempF(80), RelHumidity(70), TempF(82.95)},
+		{TempF(90), RelHumidity(60), TempF(99.68)},
+		{TempF(110), RelHumidity(40), TempF(135.66)},
+		// low humidity adjustment
+		{TempF(96), RelHumidity(5), TempF(89.04)},
+		{TempF(100), RelHumidity(10), TempF(94.12)},
+		// high humidity adjustment
+		{TempF(80), RelHumidity(90), TempF(86.34)},
+		{TempF(84), RelHumidity(95), TempF(100.88)},
+	}
+
+	for _, c := range cases {
+		result := HeatIndexNWSF(c.t, c.rh)
+		r.True(eq(result.Unwrap(), c.expected.Unwrap()), "given t %v degF + rh %v: expected %v, got %v", c.t, c.rh, c.expected, result)
+		r.True(Float64Equal(HeatIndexNWSC(c.t.C(), c.rh).Unwrap(), c.expected.C().Unwrap(), Tolerance01))
+	}
+}
+
+func Test_WindChillMetric(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance001)
+
+	cases := []struct {
+		t        TempC
+		wind     SpeedKmH
+		expected TempC
+	}{
+		{TempC(-20), SpeedKmH(30), TempC(-32.568)},
+		{TempC(0), SpeedKmH(10), TempC(-3.315)},
+		{TempC(-40), SpeedKmH(60), TempC(-64.167)},
+		// low-wind formula
+		{TempC(-10), SpeedKmH(3), TempC(-11.761)},
+		{TempC(5), SpeedKmH(4.9), TempC(4.101)},
+		{TempC(5), SpeedKmH(5), TempC(4.083)},
+		{TempC(-5), SpeedKmH(0), TempC(-5)},
+		// outside the index's range
+		{TempC(15), SpeedKmH(20), TempC(15)},
+	}
+
+	for _, c := range cases {
+		result := WindChillMetricC(c.t, c.wind)
+		r.True(eq(result.Unwrap(), c.expected.Unwrap()), "given t %v + wind %v: expected %v, got %v", c.t, c.wind, c.expected, result)
+	}
+
+	result, err := WindChillMetricCWithValidation(TempC(-20), SpeedKmH(30))
+	r.NoError(err)
+	r.True(eq(result.Unwrap(), -32.568))
+	_, err = WindChillMetricCWithValidation(TempC(15), SpeedKmH(20))
+	r.ErrorIs(err, ErrInputRange)
+	_, err = WindChillMetricCWithValidation(TempC(-5), SpeedKmH(-1))
+	r.ErrorIs(err, ErrInputRange)
+}
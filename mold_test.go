@@ -0,0 +1,81 @@
+package libwx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MoldGrowth(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance001)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	m := NewMoldGrowth(MoldSensitivityVerySensitive)
+	r.Equal(MoldRiskNone, m.Risk())
+
+	// 20 °C, 97% RH: per Hukka & Viitanen (1999), the index grows by
+	// 1/(7·exp(−0.68·ln 20 − 13.9·ln 97 + 66.02)) ≈ 0.0963 per day until it
+	// reaches 1 after ≈ 10.4 days, then twice as fast
+	const day = 24 * time.Hour
+	r.NoError(m.Add(MoldSample{Time: start, Temp: 20, RelHumidity: 97}))
+	r.NoError(m.Add(MoldSample{Time: start.Add(7 * day), Temp: 20, RelHumidity: 97}))
+	r.True(eq(m.Index(), 0.674), "expected 0.674, got %v", m.Index())
+
+	var risks []MoldRisk
+	for d := 8; d <= 120; d++ {
+		prev := m.Index()
+		r.NoError(m.Add(MoldSample{Time: start.Add(time.Duration(d) * day), Temp: 20, RelHumidity: 97}))
+		r.GreaterOrEqual(m.Index(), prev)
+		if len(risks) == 0 || risks[len(risks)-1] != m.Risk() {
+			risks = append(risks, m.Risk())
+		}
+		switch d {
+		case 10:
+			r.Equal(MoldRiskNone, m.Risk())
+		case 11:
+			r.Equal(MoldRiskLow, m.Risk())
+		}
+	}
+	r.Equal([]MoldRisk{MoldRiskNone, MoldRiskLow, MoldRiskModerate, MoldRiskHigh}, risks)
+	// growth levels off at the maximum index for these conditions:
+	// 1 + 7·0.850 − 2·0.850² ≈ 5.50
+	r.True(Float64Equal(m.Index(), 5.50, Tolerance01), "expected ~5.50, got %v", m.Index())
+
+	// 48 hours of dry conditions: 6 h at 0.00133/h, then 18 h without decline,
+	// then 24 h at 0.000667/h
+	end := start.Add(120 * day)
+	before := m.Index()
+	r.NoError(m.Add(MoldSample{Time: end, Temp: 20, RelHumidity: 50}))
+	r.NoError(m.Add(MoldSample{Time: end.Add(12 * time.Hour), Temp: 20, RelHumidity: 50}))
+	r.NoError(m.Add(MoldSample{Time: end.Add(48 * time.Hour), Temp: 20, RelHumidity: 50}))
+	r.True(eq(before-m.Index(), 0.02399), "expected decline of 0.02399, got %v", before-m.Index())
+
+	r.ErrorIs(m.Add(MoldSample{Time: end, Temp: 20, RelHumidity: 97}), ErrUnsortedInput)
+}
+
+func Test_MoldGrowth_Conditions(t *testing.T) {
+	r := require.New(t)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	grow := func(s MoldSensitivity, temp TempC, rh RelHumidity) float64 {
+		m := NewMoldGrowth(s)
+		r.NoError(m.Add(MoldSample{Time: start, Temp: temp, RelHumidity: rh}))
+		r.NoError(m.Add(MoldSample{Time: start.Add(12 * 24 * time.Hour), Temp: temp, RelHumidity: rh}))
+		return m.Index()
+	}
+
+	// below the critical humidity, or outside the temperature range, there is no growth
+	r.Zero(grow(MoldSensitivityVerySensitive, 20, 79))
+	r.Zero(grow(MoldSensitivityVerySensitive, 5, 85))
+	r.Zero(grow(MoldSensitivityVerySensitive, -5, 100))
+	r.Zero(grow(MoldSensitivityMediumResistant, 25, 84))
+
+	// growth is faster when warmer and more humid, and on more sensitive materials
+	r.Greater(grow(MoldSensitivityVerySensitive, 25, 95), grow(MoldSensitivityVerySensitive, 10, 95))
+	r.Greater(grow(MoldSensitivityVerySensitive, 20, 97), grow(MoldSensitivityVerySensitive, 20, 90))
+	r.Greater(grow(MoldSensitivityVerySensitive, 20, 97), grow(MoldSensitivitySensitive, 20, 97))
+	r.Greater(grow(MoldSensitivitySensitive, 20, 97), grow(MoldSensitivityMediumResistant, 20, 97))
+	r.Greater(grow(MoldSensitivityMediumResistant, 20, 97), grow(MoldSensitivityResistant, 20, 97))
+}
@@ -9,3 +9,14 @@ func (p PressureMb) InHg() PressureInHg {
 func (p PressureInHg) Mb() PressureMb {
 	return PressureMb(p * 33.8639)
 }
+
+// pressureMb converts any pressure type to millibars.
+func pressureMb[P Pressure](p P) PressureMb {
+	switch v := any(p).(type) {
+	case PressureInHg:
+		return v.Mb()
+	case PressureMb:
+		return v
+	}
+	panic("unreachable")
+}
@@ -0,0 +1,94 @@
+package libwx
+
+import "math"
+
+const (
+	stdLapseRate    = 0.0065 // K/m
+	gravityOverRd   = 9.80665 / 287.05
+	stdSLPExponent  = 5.257
+	wmoHumidityCorr = 0.12 // K/hPa
+)
+
+// SeaLevelPressureMb reduces the given station pressure (in millibars) to mean
+// sea-level pressure, given the station elevation (in meters) and current
+// temperature (in Celsius). This uses the hypsometric equation, assuming the
+// standard atmospheric lapse rate below the station.
+func SeaLevelPressureMb(station PressureMb, elevation Meter, temp TempC) PressureMb {
+	return PressureMb(station.Unwrap() * math.Pow(stdSLPFactor(elevation, temp), -stdSLPExponent))
+}
+
+// SeaLevelPressureInHg reduces the given station pressure (in inches of mercury)
+// to mean sea-level pressure, given the station elevation (in meters) and
+// current temperature (in Fahrenheit). This uses the hypsometric equation,
+// assuming the standard atmospheric lapse rate below the station.
+func SeaLevelPressureInHg(station PressureInHg, elevation Meter, temp TempF) PressureInHg {
+	return SeaLevelPressureMb(station.Mb(), elevation, temp.C()).InHg()
+}
+
+// StationPressureMb calculates station pressure (in millibars) from the given
+// mean sea-level pressure, station elevation (in meters) and current
+// temperature (in Celsius). It is the inverse of SeaLevelPressureMb.
+func StationPressureMb(seaLevel PressureMb, elevation Meter, temp TempC) PressureMb {
+	return PressureMb(seaLevel.Unwrap() * math.Pow(stdSLPFactor(elevation, temp), stdSLPExponent))
+}
+
+// StationPressureInHg calculates station pressure (in inches of mercury) from
+// the given mean sea-level pressure, station elevation (in meters) and current
+// temperature (in Fahrenheit). It is the inverse of SeaLevelPressureInHg.
+func StationPressureInHg(seaLevel PressureInHg, elevation Meter, temp TempF) PressureInHg {
+	return StationPressureMb(seaLevel.Mb(), elevation, temp.C()).InHg()
+}
+
+func stdSLPFactor(elevation Meter, temp TempC) float64 {
+	h := elevation.Unwrap()
+	return 1 - stdLapseRate*h/(temp.Unwrap()+stdLapseRate*h+273.15)
+}
+
+// SeaLevelPressureWMOMb reduces the given station pressure (in millibars) to
+// mean sea-level pressure, given the station elevation (in meters), the current
+// temperature and the temperature 12 hours ago (in Celsius), and the current
+// dew point (in Celsius).
+//
+// This is the reduction recommended by the WMO (Guide to Instruments and Methods
+// of Observation, WMO-No. 8), using the mean of the current and 12-hour-old
+// temperatures as the NWS does to damp the diurnal temperature cycle.
+func SeaLevelPressureWMOMb(station PressureMb, elevation Meter, temp, temp12hAgo, dewPoint TempC) PressureMb {
+	return PressureMb(station.Unwrap() * math.Exp(wmoSLPExponent(elevation, temp, temp12hAgo, dewPoint)))
+}
+
+// SeaLevelPressureWMOInHg reduces the given station pressure (in inches of
+// mercury) to mean sea-level pressure, given the station elevation (in meters),
+// the current temperature and the temperature 12 hours ago (in Fahrenheit), and
+// the current dew point (in Fahrenheit).
+//
+// See SeaLevelPressureWMOMb for details.
+func SeaLevelPressureWMOInHg(station PressureInHg, elevation Meter, temp, temp12hAgo, dewPoint TempF) PressureInHg {
+	return SeaLevelPressureWMOMb(station.Mb(), elevation, temp.C(), temp12hAgo.C(), dewPoint.C()).InHg()
+}
+
+// StationPressureWMOMb calculates station pressure (in millibars) from the given
+// mean sea-level pressure, station elevation (in meters), current temperature
+// and temperature 12 hours ago (in Celsius), and current dew point (in Celsius).
+// It is the inverse of SeaLevelPressureWMOMb.
+func StationPressureWMOMb(seaLevel PressureMb, elevation Meter, temp, temp12hAgo, dewPoint TempC) PressureMb {
+	return PressureMb(seaLevel.Unwrap() * math.Exp(-wmoSLPExponent(elevation, temp, temp12hAgo, dewPoint)))
+}
+
+// StationPressureWMOInHg calculates station pressure (in inches of mercury) from
+// the given mean sea-level pressure, station elevation (in meters), current
+// temperature and temperature 12 hours ago (in Fahrenheit), and current dew
+// point (in Fahrenheit). It is the inverse of SeaLevelPressureWMOInHg.
+func StationPressureWMOInHg(seaLevel PressureInHg, elevation Meter, temp, temp12hAgo, dewPoint TempF) PressureInHg {
+	return StationPressureWMOMb(seaLevel.Mb(), elevation, temp.C(), temp12hAgo.C(), dewPoint.C()).InHg()
+}
+
+func wmoSLPExponent(elevation Meter, temp, temp12hAgo, dewPoint TempC) float64 {
+	// ln(p0/p) = (g/R) * H / (Ts + a*H/2 + e*Ch)
+	// where Ts is the (12-hour mean) station temperature in K, a is the standard
+	// lapse rate, e is the station vapor pressure in hPa, and Ch is an empirical
+	// humidity correction.
+	h := elevation.Unwrap()
+	ts := (temp.Unwrap()+temp12hAgo.Unwrap())/2 + 273.15
+	e := magnusVaporPressure(dewPoint)
+	return gravityOverRd * h / (ts + stdLapseRate*h/2 + e*wmoHumidityCorr)
+}
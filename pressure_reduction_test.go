@@ -0,0 +1,54 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SeaLevelPressure(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance0)
+	exact := CurriedFloat64Equal(Tolerance001)
+
+	cases := []struct {
+		station   PressureMb
+		elevation Meter
+		temp      TempC
+		expected  PressureMb
+	}{
+		{PressureMb(1013.25), Meter(0), TempC(15), PressureMb(1013.25)},
+		{PressureMb(980), Meter(300), TempC(15), PressureMb(1015.4)},
+		{PressureMb(840), Meter(1600), TempC(20), PressureMb(1009.0)},
+		{PressureMb(840), Meter(1600), TempC(-10), PressureMb(1029.8)},
+	}
+
+	for _, c := range cases {
+		result := SeaLevelPressureMb(c.station, c.elevation, c.temp)
+		r.True(eq(result.Unwrap(), c.expected.Unwrap()), "given p %v at %v m and %v C: expected %v, got %v",
+			c.station, c.elevation, c.temp, c.expected, result)
+		r.True(exact(StationPressureMb(result, c.elevation, c.temp).Unwrap(), c.station.Unwrap()))
+
+		resultInHg := SeaLevelPressureInHg(c.station.InHg(), c.elevation, c.temp.F())
+		r.True(exact(resultInHg.Mb().Unwrap(), result.Unwrap()))
+		r.True(exact(StationPressureInHg(resultInHg, c.elevation, c.temp.F()).Mb().Unwrap(), c.station.Unwrap()))
+
+		resultWMO := SeaLevelPressureWMOMb(c.station, c.elevation, c.temp, c.temp, c.temp-5)
+		r.True(Float64Equal(resultWMO.Unwrap(), result.Unwrap(), 2), "given p %v at %v m and %v C: expected ~%v, got %v",
+			c.station, c.elevation, c.temp, result, resultWMO)
+		r.True(exact(StationPressureWMOMb(resultWMO, c.elevation, c.temp, c.temp, c.temp-5).Unwrap(), c.station.Unwrap()))
+	}
+}
+
+func Test_SeaLevelPressureWMO_12hMean(t *testing.T) {
+	r := require.New(t)
+
+	// a warm afternoon reading reduces to a lower SLP than the 12-hour mean temperature would
+	station := PressureMb(840)
+	afternoon := SeaLevelPressureWMOMb(station, Meter(1600), TempC(30), TempC(30), TempC(5))
+	smoothed := SeaLevelPressureWMOMb(station, Meter(1600), TempC(30), TempC(10), TempC(5))
+	r.Less(afternoon.Unwrap(), smoothed.Unwrap())
+
+	inHg := SeaLevelPressureWMOInHg(station.InHg(), Meter(1600), TempC(30).F(), TempC(10).F(), TempC(5).F())
+	r.True(Float64Equal(inHg.Mb().Unwrap(), smoothed.Unwrap(), Tolerance001))
+}
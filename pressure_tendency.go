@@ -0,0 +1,269 @@
+package libwx
+
+import (
+	"math"
+	"time"
+)
+
+const pressureTendencyPeriod = 3 * time.Hour
+
+// PressureCharacteristic is the characteristic of barometric pressure tendency
+// over the past 3 hours, per WMO code table 0200.
+type PressureCharacteristic int
+
+const (
+	// PressureCharacteristicIncreasingThenDecreasing indicates pressure increased, then decreased; it is the same as or higher than 3 hours ago.
+	PressureCharacteristicIncreasingThenDecreasing PressureCharacteristic = 0
+	// PressureCharacteristicIncreasingThenSteady indicates pressure increased, then was steady or increased more slowly; it is higher than 3 hours ago.
+	PressureCharacteristicIncreasingThenSteady PressureCharacteristic = 1
+	// PressureCharacteristicIncreasing indicates pressure increased steadily or unsteadily; it is higher than 3 hours ago.
+	PressureCharacteristicIncreasing PressureCharacteristic = 2
+	// PressureCharacteristicSteadyThenIncreasing indicates pressure decreased or was steady, then increased, or increased then increased more rapidly; it is higher than 3 hours ago.
+	PressureCharacteristicSteadyThenIncreasing PressureCharacteristic = 3
+	// PressureCharacteristicSteady indicates pressure was steady; it is the same as 3 hours ago.
+	PressureCharacteristicSteady PressureCharacteristic = 4
+	// PressureCharacteristicDecreasingThenIncreasing indicates pressure decreased, then increased; it is the same as or lower than 3 hours ago.
+	PressureCharacteristicDecreasingThenIncreasing PressureCharacteristic = 5
+	// PressureCharacteristicDecreasingThenSteady indicates pressure decreased, then was steady or decreased more slowly; it is lower than 3 hours ago.
+	PressureCharacteristicDecreasingThenSteady PressureCharacteristic = 6
+	// PressureCharacteristicDecreasing indicates pressure decreased steadily or unsteadily; it is lower than 3 hours ago.
+	PressureCharacteristicDecreasing PressureCharacteristic = 7
+	// PressureCharacteristicSteadyThenDecreasing indicates pressure increased or was steady, then decreased, or decreased then decreased more rapidly; it is lower than 3 hours ago.
+	PressureCharacteristicSteadyThenDecreasing PressureCharacteristic = 8
+)
+
+// String returns the WMO code table 0200 description of the characteristic.
+func (c PressureCharacteristic) String() string {
+	switch c {
+	case PressureCharacteristicIncreasingThenDecreasing:
+		return "increasing, then decreasing"
+	case PressureCharacteristicIncreasingThenSteady:
+		return "increasing, then steady; or increasing, then increasing more slowly"
+	case PressureCharacteristicIncreasing:
+		return "increasing (steadily or unsteadily)"
+	case PressureCharacteristicSteadyThenIncreasing:
+		return "decreasing or steady, then increasing; or increasing, then increasing more rapidly"
+	case PressureCharacteristicSteady:
+		return "steady"
+	case PressureCharacteristicDecreasingThenIncreasing:
+		return "decreasing, then increasing"
+	case PressureCharacteristicDecreasingThenSteady:
+		return "decreasing, then steady; or decreasing, then decreasing more slowly"
+	case PressureCharacteristicDecreasing:
+		return "decreasing (steadily or unsteadily)"
+	case PressureCharacteristicSteadyThenDecreasing:
+		return "steady or increasing, then decreasing; or decreasing, then decreasing more rapidly"
+	default:
+		return "unknown"
+	}
+}
+
+// PressureTrend is a simple description of barometric pressure tendency, in the
+// style used by the NWS.
+type PressureTrend int
+
+const (
+	// PressureTrendSteady indicates the net change is within the steady threshold.
+	PressureTrendSteady PressureTrend = iota
+	// PressureTrendRising indicates pressure has risen by more than the steady threshold.
+	PressureTrendRising
+	// PressureTrendRisingRapidly indicates pressure has risen at or above the rapid rate.
+	PressureTrendRisingRapidly
+	// PressureTrendFalling indicates pressure has fallen by more than the steady threshold.
+	PressureTrendFalling
+	// PressureTrendFallingRapidly indicates pressure has fallen at or above the rapid rate.
+	PressureTrendFallingRapidly
+)
+
+// String returns a human-readable description of the trend.
+func (t PressureTrend) String() string {
+	switch t {
+	case PressureTrendSteady:
+		return "Steady"
+	case PressureTrendRising:
+		return "Rising"
+	case PressureTrendRisingRapidly:
+		return "Rising Rapidly"
+	case PressureTrendFalling:
+		return "Falling"
+	case PressureTrendFallingRapidly:
+		return "Falling Rapidly"
+	default:
+		return "Unknown"
+	}
+}
+
+// PressureTendencyThresholds configures the classification of pressure tendency.
+type PressureTendencyThresholds[P Pressure] struct {
+	// Steady is the largest change, over the whole period or over either half
+	// of it, that is considered steady.
+	Steady P
+	// RapidPerHour is the smallest average rate of change (per hour) over the
+	// whole period that is considered rapid.
+	RapidPerHour P
+}
+
+// DefaultPressureTendencyThresholdsMb are the default pressure tendency thresholds
+// for pressure in millibars. The rapid rate matches the NWS criterion for
+// reporting pressure rising/falling rapidly (0.06 inHg/hour).
+var DefaultPressureTendencyThresholdsMb = PressureTendencyThresholds[PressureMb]{
+	Steady:       0.5,
+	RapidPerHour: PressureInHg(0.06).Mb(),
+}
+
+// DefaultPressureTendencyThresholdsInHg are the default pressure tendency thresholds
+// for pressure in inches of mercury. The rapid rate matches the NWS criterion for
+// reporting pressure rising/falling rapidly (0.06 inHg/hour).
+var DefaultPressureTendencyThresholdsInHg = PressureTendencyThresholds[PressureInHg]{
+	Steady:       PressureMb(0.5).InHg(),
+	RapidPerHour: 0.06,
+}
+
+// PressureTendency describes barometric pressure tendency over the past 3 hours.
+type PressureTendency[P Pressure] struct {
+	Characteristic PressureCharacteristic
+	Trend          PressureTrend
+	// Change is the net change in pressure over the period.
+	Change P
+	// RatePerHour is the average rate of change in pressure over the period.
+	RatePerHour P
+}
+
+// PressureTendencyFromSeries calculates the barometric pressure tendency over
+// the 3 hours ending at the last of the given time-stamped pressure samples.
+// Pressure at the start and midpoint of the period are linearly interpolated
+// between samples; the characteristic is determined by comparing the change
+// over the first and second halves of the period.
+//
+// times must be in chronological order and span at least 3 hours.
+func PressureTendencyFromSeries[P Pressure](times []time.Time, pressures []P, thresholds PressureTendencyThresholds[P]) (PressureTendency[P], error) {
+	if len(times) != len(pressures) {
+		return PressureTendency[P]{}, ErrMismatchedInputLength
+	}
+	if len(times) < 2 {
+		return PressureTendency[P]{}, ErrInsufficientData
+	}
+	for i := 1; i < len(times); i++ {
+		if times[i].Before(times[i-1]) {
+			return PressureTendency[P]{}, ErrUnsortedInput
+		}
+	}
+	end := times[len(times)-1]
+	start := end.Add(-pressureTendencyPeriod)
+	if times[0].After(start) {
+		return PressureTendency[P]{}, ErrInsufficientData
+	}
+
+	v := make([]float64, len(pressures))
+	for i, p := range pressures {
+		v[i] = float64(p)
+	}
+	pStart := interpolateAt(times, v, start)
+	pMid := interpolateAt(times, v, start.Add(pressureTendencyPeriod/2))
+	pEnd := v[len(v)-1]
+
+	net := pEnd - pStart
+	rate := net / pressureTendencyPeriod.Hours()
+	steady := float64(thresholds.Steady)
+
+	retv := PressureTendency[P]{
+		Characteristic: pressureCharacteristic(net, pMid-pStart, pEnd-pMid, steady),
+		Change:         P(net),
+		RatePerHour:    P(rate),
+	}
+	switch steadySign(net, steady) {
+	case 1:
+		retv.Trend = PressureTrendRising
+		if rate >= float64(thresholds.RapidPerHour) {
+			retv.Trend = PressureTrendRisingRapidly
+		}
+	case -1:
+		retv.Trend = PressureTrendFalling
+		if -rate >= float64(thresholds.RapidPerHour) {
+			retv.Trend = PressureTrendFallingRapidly
+		}
+	default:
+		retv.Trend = PressureTrendSteady
+	}
+	return retv, nil
+}
+
+func pressureCharacteristic(net, first, second, steady float64) PressureCharacteristic {
+	s1 := steadySign(first, steady)
+	s2 := steadySign(second, steady)
+	sNet := steadySign(net, steady)
+
+	switch {
+	case s1 == 0 && s2 == 0:
+		if sNet > 0 {
+			return PressureCharacteristicIncreasing
+		}
+		if sNet < 0 {
+			return PressureCharacteristicDecreasing
+		}
+		return PressureCharacteristicSteady
+	case s1 > 0 && s2 < 0:
+		if sNet >= 0 {
+			return PressureCharacteristicIncreasingThenDecreasing
+		}
+		return PressureCharacteristicSteadyThenDecreasing
+	case s1 < 0 && s2 > 0:
+		if sNet <= 0 {
+			return PressureCharacteristicDecreasingThenIncreasing
+		}
+		return PressureCharacteristicSteadyThenIncreasing
+	case s1 > 0 && s2 == 0:
+		return PressureCharacteristicIncreasingThenSteady
+	case s1 < 0 && s2 == 0:
+		return PressureCharacteristicDecreasingThenSteady
+	case s1 == 0 && s2 > 0:
+		return PressureCharacteristicSteadyThenIncreasing
+	case s1 == 0 && s2 < 0:
+		return PressureCharacteristicSteadyThenDecreasing
+	case s1 > 0 && s2 > 0:
+		if second > 2*first {
+			return PressureCharacteristicSteadyThenIncreasing
+		}
+		if second < first/2 {
+			return PressureCharacteristicIncreasingThenSteady
+		}
+		return PressureCharacteristicIncreasing
+	default:
+		if second < 2*first {
+			return PressureCharacteristicSteadyThenDecreasing
+		}
+		if second > first/2 {
+			return PressureCharacteristicDecreasingThenSteady
+		}
+		return PressureCharacteristicDecreasing
+	}
+}
+
+// steadySign returns 1 or -1 if x is greater than steady in magnitude, and 0 otherwise.
+func steadySign(x, steady float64) int {
+	if math.Abs(x) <= steady {
+		return 0
+	}
+	if x > 0 {
+		return 1
+	}
+	return -1
+}
+
+// interpolateAt linearly interpolates the value at time t from the given
+// time-stamped values. times must be in chronological order, and t must be
+// within the range of times.
+func interpolateAt(times []time.Time, v []float64, t time.Time) float64 {
+	for i := 1; i < len(times); i++ {
+		if times[i].Before(t) {
+			continue
+		}
+		span := times[i].Sub(times[i-1])
+		if span == 0 {
+			return v[i]
+		}
+		frac := float64(t.Sub(times[i-1])) / float64(span)
+		return v[i-1] + frac*(v[i]-v[i-1])
+	}
+	return v[len(v)-1]
+}
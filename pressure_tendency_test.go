@@ -0,0 +1,106 @@
+package libwx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// pressureSeries returns samples every minute for 3 hours, following f,
+// which is given the elapsed fraction (0-1) of the period.
+func pressureSeries(f func(x float64) PressureMb) ([]time.Time, []PressureMb) {
+	start := time.Date(2024, 7, 17, 0, 0, 0, 0, time.UTC)
+	var (
+		times     []time.Time
+		pressures []PressureMb
+	)
+	for i := 0; i <= 180; i++ {
+		times = append(times, start.Add(time.Duration(i)*time.Minute))
+		pressures = append(pressures, f(float64(i)/180.0))
+	}
+	return times, pressures
+}
+
+func Test_PressureTendency_Characteristic(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		desc     string
+		f        func(x float64) PressureMb
+		expected PressureCharacteristic
+		trend    PressureTrend
+	}{
+		{"steady", func(x float64) PressureMb { return 1013 + PressureMb(0.2*x) }, PressureCharacteristicSteady, PressureTrendSteady},
+		{"rising", func(x float64) PressureMb { return 1010 + PressureMb(3*x) }, PressureCharacteristicIncreasing, PressureTrendRising},
+		{"falling rapidly", func(x float64) PressureMb { return 1010 - PressureMb(9*x) }, PressureCharacteristicDecreasing, PressureTrendFallingRapidly},
+		{"rising then falling, higher", func(x float64) PressureMb {
+			if x < 0.5 {
+				return 1010 + PressureMb(4*x)
+			}
+			return 1012 - PressureMb(2*(x-0.5))
+		}, PressureCharacteristicIncreasingThenDecreasing, PressureTrendRising},
+		{"rising then falling, lower", func(x float64) PressureMb {
+			if x < 0.5 {
+				return 1010 + PressureMb(2*x)
+			}
+			return 1011 - PressureMb(6*(x-0.5))
+		}, PressureCharacteristicSteadyThenDecreasing, PressureTrendFalling},
+		{"falling then rising, same", func(x float64) PressureMb {
+			if x < 0.5 {
+				return 1010 - PressureMb(4*x)
+			}
+			return 1008 + PressureMb(4*(x-0.5))
+		}, PressureCharacteristicDecreasingThenIncreasing, PressureTrendSteady},
+		{"falling then steady", func(x float64) PressureMb {
+			if x < 0.5 {
+				return 1010 - PressureMb(4*x)
+			}
+			return 1008
+		}, PressureCharacteristicDecreasingThenSteady, PressureTrendFalling},
+		{"steady then rising", func(x float64) PressureMb {
+			if x < 0.5 {
+				return 1010
+			}
+			return 1010 + PressureMb(4*(x-0.5))
+		}, PressureCharacteristicSteadyThenIncreasing, PressureTrendRising},
+	}
+
+	for _, c := range cases {
+		times, pressures := pressureSeries(c.f)
+		result, err := PressureTendencyFromSeries(times, pressures, DefaultPressureTendencyThresholdsMb)
+		r.NoError(err, c.desc)
+		r.Equal(c.expected, result.Characteristic, "%s: expected %v, got %v", c.desc, c.expected, result.Characteristic)
+		r.Equal(c.trend, result.Trend, "%s: expected %v, got %v", c.desc, c.trend, result.Trend)
+	}
+}
+
+func Test_PressureTendency_ChangeAndRate(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance001)
+
+	times, pressures := pressureSeries(func(x float64) PressureMb { return 1010 - PressureMb(4.5*x) })
+	result, err := PressureTendencyFromSeries(times, pressures, DefaultPressureTendencyThresholdsMb)
+	r.NoError(err)
+	r.True(eq(result.Change.Unwrap(), -4.5))
+	r.True(eq(result.RatePerHour.Unwrap(), -1.5))
+	r.Equal(PressureTrendFalling, result.Trend)
+
+	thresholds := DefaultPressureTendencyThresholdsMb
+	thresholds.RapidPerHour = 1.5
+	result, err = PressureTendencyFromSeries(times, pressures, thresholds)
+	r.NoError(err)
+	r.Equal(PressureTrendFallingRapidly, result.Trend)
+
+	inHg := make([]PressureInHg, len(pressures))
+	for i, p := range pressures {
+		inHg[i] = p.InHg()
+	}
+	resultInHg, err := PressureTendencyFromSeries(times, inHg, DefaultPressureTendencyThresholdsInHg)
+	r.NoError(err)
+	r.True(eq(resultInHg.Change.Mb().Unwrap(), -4.5))
+	r.Equal(PressureCharacteristicDecreasing, resultInHg.Characteristic)
+
+	_, err = PressureTendencyFromSeries(times[60:], pressures[60:], DefaultPressureTendencyThresholdsMb)
+	r.ErrorIs(err, ErrInsufficientData)
+}
@@ -6,5 +6,10 @@ type PressureInHg float64
 // PressureMb represents barometric pressure in millibars.
 type PressureMb float64
 
+// Pressure is a constraint satisfied by all pressure types.
+type Pressure interface {
+	PressureMb | PressureInHg
+}
+
 func (p PressureMb) Unwrap() float64   { return float64(p) }
 func (p PressureInHg) Unwrap() float64 { return float64(p) }
@@ -0,0 +1,132 @@
+package libwx
+
+// Psychrometer identifies the type of psychrometer used to take dry and wet
+// bulb readings, which determines the psychrometer coefficient.
+type Psychrometer int
+
+const (
+	// PsychrometerAspirated is an Assmann-type psychrometer, or a sling
+	// psychrometer, with the bulbs ventilated at 2.2 m/s or more.
+	PsychrometerAspirated Psychrometer = iota
+	// PsychrometerNonAspirated is a psychrometer in a naturally ventilated
+	// screen.
+	PsychrometerNonAspirated
+)
+
+// PsychrometerBulb identifies whether the psychrometer's wet bulb is covered
+// with liquid water or with ice.
+type PsychrometerBulb int
+
+const (
+	// PsychrometerBulbWet is a wet bulb covered with liquid (possibly
+	// supercooled) water.
+	PsychrometerBulbWet PsychrometerBulb = iota
+	// PsychrometerBulbIced is a wet bulb covered with ice, as is usual below
+	// freezing.
+	PsychrometerBulbIced
+)
+
+// coefficient returns the psychrometer coefficient (in 1/K) for the given
+// wet bulb temperature (in Celsius) and bulb, per WMO-No. 8.
+func (p Psychrometer) coefficient(tw float64, bulb PsychrometerBulb) float64 {
+	if p == PsychrometerNonAspirated {
+		if bulb == PsychrometerBulbIced {
+			return 7.20e-4
+		}
+		return 7.99e-4
+	}
+	if bulb == PsychrometerBulbIced {
+		return 5.75e-4
+	}
+	return 6.53e-4 * (1 + 0.000944*tw)
+}
+
+// psychrometricVaporPressure returns the vapor pressure (in hPa) implied by
+// the given dry and wet bulb temperatures (in Celsius) and station pressure
+// (in hPa), per the psychrometric equation in WMO-No. 8.
+func psychrometricVaporPressure(t, tw, p float64, psychrometer Psychrometer, bulb PsychrometerBulb) float64 {
+	es := magnusVaporPressure(TempC(tw))
+	if bulb == PsychrometerBulbIced {
+		es = magnusIceVaporPressure(tw)
+	}
+	return es - psychrometer.coefficient(tw, bulb)*p*(t-tw)
+}
+
+// VaporPressureFromWetBulbF calculates vapor pressure from the given dry and
+// wet bulb temperatures (in Fahrenheit) and station pressure (in any pressure
+// type), for the given type of psychrometer and bulb.
+// If a (liquid) wet bulb is above the dry bulb, or the readings imply a negative
+// vapor pressure, ErrInputRange is returned.
+func VaporPressureFromWetBulbF[P Pressure](dry, wet TempF, p P, psychrometer Psychrometer, bulb PsychrometerBulb) (VaporPressure, error) {
+	return VaporPressureFromWetBulbC(dry.C(), wet.C(), p, psychrometer, bulb)
+}
+
+// VaporPressureFromWetBulbC calculates vapor pressure from the given dry and
+// wet bulb temperatures (in Celsius) and station pressure (in any pressure
+// type), for the given type of psychrometer and bulb.
+// If a (liquid) wet bulb is above the dry bulb, or the readings imply a negative
+// vapor pressure, ErrInputRange is returned.
+func VaporPressureFromWetBulbC[P Pressure](dry, wet TempC, p P, psychrometer Psychrometer, bulb PsychrometerBulb) (VaporPressure, error) {
+	pMb := pressureMb(p).Unwrap()
+	if pMb <= 0 {
+		return 0, ErrInputRange
+	}
+	// an iced bulb may legitimately read above the dry bulb, since near-saturated
+	// air below freezing is supersaturated with respect to ice
+	if bulb == PsychrometerBulbWet && Float64Compare(wet.Unwrap(), dry.Unwrap(), 1e-9) > 0 {
+		return 0, ErrInputRange
+	}
+	e := psychrometricVaporPressure(dry.Unwrap(), wet.Unwrap(), pMb, psychrometer, bulb)
+	if e < 0 {
+		return 0, ErrInputRange
+	}
+	return VaporPressure(e), nil
+}
+
+// RelHumidityFromWetBulbF calculates relative humidity from the given dry and
+// wet bulb temperatures (in Fahrenheit) and station pressure (in any pressure
+// type), for the given type of psychrometer and bulb.
+// If a (liquid) wet bulb is above the dry bulb, or the readings imply a negative
+// vapor pressure, ErrInputRange is returned.
+func RelHumidityFromWetBulbF[P Pressure](dry, wet TempF, p P, psychrometer Psychrometer, bulb PsychrometerBulb) (RelHumidity, error) {
+	return RelHumidityFromWetBulbC(dry.C(), wet.C(), p, psychrometer, bulb)
+}
+
+// RelHumidityFromWetBulbC calculates relative humidity from the given dry and
+// wet bulb temperatures (in Celsius) and station pressure (in any pressure
+// type), for the given type of psychrometer and bulb.
+// If a (liquid) wet bulb is above the dry bulb, or the readings imply a negative
+// vapor pressure, ErrInputRange is returned.
+func RelHumidityFromWetBulbC[P Pressure](dry, wet TempC, p P, psychrometer Psychrometer, bulb PsychrometerBulb) (RelHumidity, error) {
+	e, err := VaporPressureFromWetBulbC(dry, wet, p, psychrometer, bulb)
+	if err != nil {
+		return 0, err
+	}
+	return RelHumidityFromVaporPressureC(dry, e), nil
+}
+
+// DewPointFFromWetBulb calculates the dew point (in Fahrenheit) from the given
+// dry and wet bulb temperatures (in Fahrenheit) and station pressure (in any
+// pressure type), for the given type of psychrometer and bulb.
+// If a (liquid) wet bulb is above the dry bulb, or the readings imply a vapor
+// pressure that is not positive, ErrInputRange is returned.
+func DewPointFFromWetBulb[P Pressure](dry, wet TempF, p P, psychrometer Psychrometer, bulb PsychrometerBulb) (TempF, error) {
+	result, err := DewPointCFromWetBulb(dry.C(), wet.C(), p, psychrometer, bulb)
+	return result.F(), err
+}
+
+// DewPointCFromWetBulb calculates the dew point (in Celsius) from the given
+// dry and wet bulb temperatures (in Celsius) and station pressure (in any
+// pressure type), for the given type of psychrometer and bulb.
+// If a (liquid) wet bulb is above the dry bulb, or the readings imply a vapor
+// pressure that is not positive, ErrInputRange is returned.
+func DewPointCFromWetBulb[P Pressure](dry, wet TempC, p P, psychrometer Psychrometer, bulb PsychrometerBulb) (TempC, error) {
+	e, err := VaporPressureFromWetBulbC(dry, wet, p, psychrometer, bulb)
+	if err != nil {
+		return dry, err
+	}
+	if e == 0 {
+		return dry, ErrInputRange
+	}
+	return DewPointCFromVaporPressure(e), nil
+}
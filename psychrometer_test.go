@@ -0,0 +1,78 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Psychrometer(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		t    TempC
+		rh   RelHumidity
+		p    PressureMb
+		bulb PsychrometerBulb
+	}{
+		{TempC(20), RelHumidity(50), PressureMb(1013.25), PsychrometerBulbWet},
+		{TempC(30), RelHumidity(80), PressureMb(1013.25), PsychrometerBulbWet},
+		{TempC(25), RelHumidity(20), PressureMb(850), PsychrometerBulbWet},
+		{TempC(-5), RelHumidity(50), PressureMb(1013.25), PsychrometerBulbIced},
+		{TempC(-20), RelHumidity(80), PressureMb(1000), PsychrometerBulbIced},
+		// saturated with respect to water, so the iced bulb reads above the dry bulb
+		{TempC(-10), RelHumidity(100), PressureMb(1013.25), PsychrometerBulbIced},
+		{TempC(-5), RelHumidity(100), PressureMb(1013.25), PsychrometerBulbIced},
+		{TempC(-1), RelHumidity(100), PressureMb(1013.25), PsychrometerBulbIced},
+	}
+
+	for _, c := range cases {
+		wet, err := WetBulbCWithPressure(c.t, c.rh, c.p)
+		r.NoError(err)
+
+		e, err := VaporPressureFromWetBulbC(c.t, wet, c.p, PsychrometerAspirated, c.bulb)
+		r.NoError(err)
+		r.True(Float64Equal(e.Unwrap(), VaporPressureFromRelC(c.t, c.rh).Unwrap(), Tolerance001), "given t %v + rh %v: got e %v", c.t, c.rh, e)
+
+		rh, err := RelHumidityFromWetBulbC(c.t, wet, c.p, PsychrometerAspirated, c.bulb)
+		r.NoError(err)
+		r.Equal(c.rh, rh)
+
+		rh, err = RelHumidityFromWetBulbF(c.t.F(), wet.F(), c.p.InHg(), PsychrometerAspirated, c.bulb)
+		r.NoError(err)
+		r.Equal(c.rh, rh)
+
+		dp, err := DewPointCFromWetBulb(c.t, wet, c.p, PsychrometerAspirated, c.bulb)
+		r.NoError(err)
+		r.True(Float64Equal(dp.Unwrap(), DewPointC(c.t, c.rh).Unwrap(), Tolerance001))
+
+		dpF, err := DewPointFFromWetBulb(c.t.F(), wet.F(), c.p, PsychrometerAspirated, c.bulb)
+		r.NoError(err)
+		r.True(Float64Equal(dpF.C().Unwrap(), dp.Unwrap(), Tolerance001))
+
+		// a naturally ventilated bulb is depressed less, so the same readings imply drier air
+		eNonAspirated, err := VaporPressureFromWetBulbC(c.t, wet, c.p, PsychrometerNonAspirated, c.bulb)
+		r.NoError(err)
+		if wet < c.t {
+			r.Less(eNonAspirated.Unwrap(), e.Unwrap())
+		} else {
+			r.Greater(eNonAspirated.Unwrap(), e.Unwrap())
+		}
+	}
+
+	e, err := VaporPressureFromWetBulbF(TempF(68), TempF(68), PressureInHg(29.92), PsychrometerAspirated, PsychrometerBulbWet)
+	r.NoError(err)
+	r.True(Float64Equal(e.Unwrap(), VaporPressureFromRelC(TempC(20), RelHumidity(100)).Unwrap(), Tolerance001))
+
+	_, err = RelHumidityFromWetBulbC(TempC(20), TempC(21), PressureMb(1013.25), PsychrometerAspirated, PsychrometerBulbWet)
+	r.ErrorIs(err, ErrInputRange)
+	wet, err := WetBulbCWithPressure(TempC(-10), RelHumidity(100), PressureMb(1013.25))
+	r.NoError(err)
+	r.True(Float64Equal(wet.Unwrap(), -9.67, Tolerance01), "expected iced bulb -9.67, got %v", wet)
+	_, err = RelHumidityFromWetBulbC(TempC(-10), wet, PressureMb(1013.25), PsychrometerAspirated, PsychrometerBulbWet)
+	r.ErrorIs(err, ErrInputRange)
+	_, err = DewPointCFromWetBulb(TempC(40), TempC(5), PressureMb(1013.25), PsychrometerAspirated, PsychrometerBulbWet)
+	r.ErrorIs(err, ErrInputRange)
+	_, err = VaporPressureFromWetBulbC(TempC(20), TempC(15), PressureMb(0), PsychrometerAspirated, PsychrometerBulbWet)
+	r.ErrorIs(err, ErrInputRange)
+}
@@ -0,0 +1,37 @@
+package libwx
+
+import "math"
+
+const (
+	solveTolerance = 1e-9
+	solveMaxIter   = 200
+)
+
+// bisect finds a root of f within [lo, hi] using the bisection method.
+// f(lo) and f(hi) must have opposite signs (or one must be zero); otherwise
+// ErrInputRange is returned.
+func bisect(f func(float64) float64, lo, hi float64) (float64, error) {
+	fLo, fHi := f(lo), f(hi)
+	if fLo == 0 {
+		return lo, nil
+	}
+	if fHi == 0 {
+		return hi, nil
+	}
+	if math.IsNaN(fLo) || math.IsNaN(fHi) || math.Signbit(fLo) == math.Signbit(fHi) {
+		return 0, ErrInputRange
+	}
+	for i := 0; i < solveMaxIter && hi-lo > solveTolerance; i++ {
+		mid := (lo + hi) / 2
+		fMid := f(mid)
+		if fMid == 0 {
+			return mid, nil
+		}
+		if math.Signbit(fMid) == math.Signbit(fLo) {
+			lo, fLo = mid, fMid
+		} else {
+			hi = mid
+		}
+	}
+	return (lo + hi) / 2, nil
+}
@@ -10,6 +10,11 @@ func (s SpeedMph) Knots() SpeedKnots {
 	return SpeedKnots(s / 1.15078)
 }
 
+// Mps returns the speed in meters per second.
+func (s SpeedMph) Mps() SpeedMps {
+	return SpeedMps(s * 0.44704)
+}
+
 // Mph returns the speed in miles per hour.
 func (s SpeedKmH) Mph() SpeedMph {
 	return SpeedMph(s / 1.60934)
@@ -20,6 +25,11 @@ func (s SpeedKmH) Knots() SpeedKnots {
 	return SpeedKnots(s / 1.852)
 }
 
+// Mps returns the speed in meters per second.
+func (s SpeedKmH) Mps() SpeedMps {
+	return SpeedMps(s / 3.6)
+}
+
 // Mph returns the speed in miles per hour.
 func (s SpeedKnots) Mph() SpeedMph {
 	return SpeedMph(s * 1.15078)
@@ -29,3 +39,38 @@ func (s SpeedKnots) Mph() SpeedMph {
 func (s SpeedKnots) KmH() SpeedKmH {
 	return SpeedKmH(s * 1.852)
 }
+
+// Mps returns the speed in meters per second.
+func (s SpeedKnots) Mps() SpeedMps {
+	return SpeedMps(s * 1.852 / 3.6)
+}
+
+// Mph returns the speed in miles per hour.
+func (s SpeedMps) Mph() SpeedMph {
+	return SpeedMph(s / 0.44704)
+}
+
+// KmH returns the speed in kilometers per hour.
+func (s SpeedMps) KmH() SpeedKmH {
+	return SpeedKmH(s * 3.6)
+}
+
+// Knots returns the speed in knots.
+func (s SpeedMps) Knots() SpeedKnots {
+	return SpeedKnots(s * 3.6 / 1.852)
+}
+
+// speedMps converts any speed type to meters per second.
+func speedMps[S Speed](s S) SpeedMps {
+	switch v := any(s).(type) {
+	case SpeedMph:
+		return v.Mps()
+	case SpeedKmH:
+		return v.Mps()
+	case SpeedKnots:
+		return v.Mps()
+	case SpeedMps:
+		return v
+	}
+	panic("unreachable")
+}
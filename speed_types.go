@@ -9,6 +9,15 @@ type SpeedKmH float64
 // SpeedKnots represents speed in knots.
 type SpeedKnots float64
 
+// SpeedMps represents speed in meters per second.
+type SpeedMps float64
+
+// Speed is a constraint satisfied by all speed types.
+type Speed interface {
+	SpeedMph | SpeedKmH | SpeedKnots | SpeedMps
+}
+
 func (s SpeedMph) Unwrap() float64   { return float64(s) }
 func (s SpeedKmH) Unwrap() float64   { return float64(s) }
 func (s SpeedKnots) Unwrap() float64 { return float64(s) }
+func (s SpeedMps) Unwrap() float64   { return float64(s) }
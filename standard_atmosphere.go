@@ -0,0 +1,128 @@
+package libwx
+
+import "math"
+
+// The U.S. Standard Atmosphere 1976, which is identical to the ICAO/ISO
+// International Standard Atmosphere up to 32 km.
+// See: https://ntrs.nasa.gov/citations/19770009539
+const (
+	stdAtmosG0        = 9.80665     // m/s^2
+	stdAtmosR         = 287.0528742 // J/(kg*K), R* / M0
+	stdAtmosGamma     = 1.4
+	stdAtmosEarthR    = 6356766.0 // m, effective Earth radius for geopotential height
+	stdAtmosMinHeight = -5000.0   // m, geopotential
+	stdAtmosMaxHeight = 84852.0   // m, geopotential (86 km geometric)
+	stdAtmosT0        = 288.15    // K
+	stdAtmosP0        = 1013.25   // hPa
+)
+
+type stdAtmosLayer struct {
+	baseHeight float64 // m, geopotential
+	baseTemp   float64 // K
+	baseP      float64 // hPa
+	lapseRate  float64 // K/m
+}
+
+var stdAtmosLayers = func() []stdAtmosLayer {
+	layers := []stdAtmosLayer{
+		{baseHeight: 0, lapseRate: -0.0065},
+		{baseHeight: 11000, lapseRate: 0},
+		{baseHeight: 20000, lapseRate: 0.001},
+		{baseHeight: 32000, lapseRate: 0.0028},
+		{baseHeight: 47000, lapseRate: 0},
+		{baseHeight: 51000, lapseRate: -0.0028},
+		{baseHeight: 71000, lapseRate: -0.002},
+	}
+	layers[0].baseTemp = stdAtmosT0
+	layers[0].baseP = stdAtmosP0
+	for i := 1; i < len(layers); i++ {
+		layers[i].baseTemp, layers[i].baseP = layers[i-1].at(layers[i].baseHeight)
+	}
+	return layers
+}()
+
+// at returns the temperature (K) and pressure (hPa) at the given geopotential
+// height within (or extrapolated from) the layer.
+func (l stdAtmosLayer) at(h float64) (float64, float64) {
+	dh := h - l.baseHeight
+	t := l.baseTemp + l.lapseRate*dh
+	if l.lapseRate == 0 {
+		return t, l.baseP * math.Exp(-stdAtmosG0*dh/(stdAtmosR*l.baseTemp))
+	}
+	return t, l.baseP * math.Pow(l.baseTemp/t, stdAtmosG0/(stdAtmosR*l.lapseRate))
+}
+
+// heightAt returns the geopotential height (m) at which the given pressure (hPa)
+// occurs within (or extrapolated from) the layer.
+func (l stdAtmosLayer) heightAt(p float64) float64 {
+	if l.lapseRate == 0 {
+		return l.baseHeight - stdAtmosR*l.baseTemp/stdAtmosG0*math.Log(p/l.baseP)
+	}
+	return l.baseHeight + l.baseTemp/l.lapseRate*(math.Pow(p/l.baseP, -stdAtmosR*l.lapseRate/stdAtmosG0)-1)
+}
+
+// StdAtmosphere describes the state of the standard atmosphere at some altitude.
+type StdAtmosphere struct {
+	Temp         TempC
+	Pressure     PressureMb
+	Density      Density
+	SpeedOfSound SpeedMps
+}
+
+// StandardAtmosphere returns the temperature, pressure, density and speed of
+// sound in the U.S. Standard Atmosphere 1976 (equivalent to the International
+// Standard Atmosphere through 32 km) at the given geopotential altitude.
+// If the altitude is below -5 km or above 84.852 km (86 km geometric),
+// ErrInputRange is returned.
+func StandardAtmosphere(geopotential Meter) (StdAtmosphere, error) {
+	h := geopotential.Unwrap()
+	if h < stdAtmosMinHeight || h > stdAtmosMaxHeight {
+		return StdAtmosphere{}, ErrInputRange
+	}
+
+	layer := stdAtmosLayers[0]
+	for _, l := range stdAtmosLayers {
+		if h >= l.baseHeight {
+			layer = l
+		}
+	}
+	tK, p := layer.at(h)
+	return StdAtmosphere{
+		Temp:         TempC(tK - 273.15),
+		Pressure:     PressureMb(p),
+		Density:      Density(p * 100 / (stdAtmosR * tK)),
+		SpeedOfSound: SpeedMps(math.Sqrt(stdAtmosGamma * stdAtmosR * tK)),
+	}, nil
+}
+
+// StandardAtmosphereAltitude returns the geopotential altitude at which the
+// given pressure occurs in the U.S. Standard Atmosphere 1976.
+// If the pressure is outside the range covered by the model (-5 km to 84.852 km
+// geopotential), ErrInputRange is returned.
+func StandardAtmosphereAltitude(p PressureMb) (Meter, error) {
+	_, pMax := stdAtmosLayers[0].at(stdAtmosMinHeight)
+	_, pMin := stdAtmosLayers[len(stdAtmosLayers)-1].at(stdAtmosMaxHeight)
+	if p.Unwrap() > pMax || p.Unwrap() < pMin {
+		return 0, ErrInputRange
+	}
+
+	layer := stdAtmosLayers[0]
+	for _, l := range stdAtmosLayers {
+		if p.Unwrap() <= l.baseP {
+			layer = l
+		}
+	}
+	return Meter(layer.heightAt(p.Unwrap())), nil
+}
+
+// GeopotentialAltitude converts the given geometric altitude (height above mean
+// sea level) to geopotential altitude.
+func GeopotentialAltitude(geometric Meter) Meter {
+	return Meter(stdAtmosEarthR * geometric.Unwrap() / (stdAtmosEarthR + geometric.Unwrap()))
+}
+
+// GeometricAltitude converts the given geopotential altitude to geometric
+// altitude (height above mean sea level).
+func GeometricAltitude(geopotential Meter) Meter {
+	return Meter(stdAtmosEarthR * geopotential.Unwrap() / (stdAtmosEarthR - geopotential.Unwrap()))
+}
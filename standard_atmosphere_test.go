@@ -0,0 +1,58 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_StandardAtmosphere(t *testing.T) {
+	r := require.New(t)
+
+	// reference values from the U.S. Standard Atmosphere 1976 tables
+	cases := []struct {
+		h        Meter
+		temp     TempC
+		pressure PressureMb
+	}{
+		{Meter(-2000), TempC(28.0), PressureMb(1277.8)},
+		{Meter(0), TempC(15.0), PressureMb(1013.25)},
+		{Meter(5000), TempC(-17.5), PressureMb(540.48)},
+		{Meter(11000), TempC(-56.5), PressureMb(226.32)},
+		{Meter(20000), TempC(-56.5), PressureMb(54.748)},
+		{Meter(32000), TempC(-44.5), PressureMb(8.6802)},
+		{Meter(47000), TempC(-2.5), PressureMb(1.1091)},
+		{Meter(51000), TempC(-2.5), PressureMb(0.66939)},
+		{Meter(71000), TempC(-58.5), PressureMb(0.039564)},
+		{Meter(84852), TempC(-86.204), PressureMb(0.0037338)},
+	}
+
+	for _, c := range cases {
+		result, err := StandardAtmosphere(c.h)
+		r.NoError(err)
+		r.True(Float64Equal(result.Temp.Unwrap(), c.temp.Unwrap(), Tolerance01), "at %v m: expected %v C, got %v", c.h, c.temp, result.Temp)
+		r.True(Float64Equal(result.Pressure.Unwrap()/c.pressure.Unwrap(), 1, Tolerance001), "at %v m: expected %v mb, got %v", c.h, c.pressure, result.Pressure)
+
+		h, err := StandardAtmosphereAltitude(result.Pressure)
+		r.NoError(err)
+		r.True(Float64Equal(h.Unwrap(), c.h.Unwrap(), Tolerance01), "for %v mb: expected %v m, got %v", result.Pressure, c.h, h)
+	}
+
+	sl, err := StandardAtmosphere(Meter(0))
+	r.NoError(err)
+	r.True(Float64Equal(sl.Density.Unwrap(), 1.225, Tolerance001))
+	r.True(Float64Equal(sl.SpeedOfSound.Unwrap(), 340.29, Tolerance01))
+
+	_, err = StandardAtmosphere(Meter(90000))
+	r.ErrorIs(err, ErrInputRange)
+	_, err = StandardAtmosphereAltitude(PressureMb(2000))
+	r.ErrorIs(err, ErrInputRange)
+}
+
+func Test_GeopotentialAltitude(t *testing.T) {
+	r := require.New(t)
+
+	r.True(Float64Equal(GeometricAltitude(Meter(84852)).Unwrap(), 86000, Tolerance0))
+	r.True(Float64Equal(GeopotentialAltitude(Meter(86000)).Unwrap(), 84852, Tolerance0))
+	r.True(Float64Equal(GeopotentialAltitude(GeometricAltitude(Meter(11000))).Unwrap(), 11000, Tolerance001))
+}
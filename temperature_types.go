@@ -23,3 +23,35 @@ const (
 	// HeatIndexWarningExtremeDanger indicates heat stroke is imminent.
 	HeatIndexWarningExtremeDanger
 )
+
+// HumidexWarning represents an Environment Canada humidex comfort category.
+type HumidexWarning int
+
+const (
+	// HumidexWarningNone indicates little or no discomfort (humidex below 30).
+	HumidexWarningNone HumidexWarning = iota
+	// HumidexWarningSomeDiscomfort indicates some discomfort (humidex 30 to 39).
+	HumidexWarningSomeDiscomfort
+	// HumidexWarningGreatDiscomfort indicates great discomfort; avoid exertion (humidex 40 to 45).
+	HumidexWarningGreatDiscomfort
+	// HumidexWarningDangerous indicates dangerous conditions (humidex above 45).
+	HumidexWarningDangerous
+	// HumidexWarningHeatStroke indicates heat stroke is probable (humidex 54 and above).
+	HumidexWarningHeatStroke
+)
+
+// WindChillWarning represents a wind chill risk category.
+type WindChillWarning int
+
+const (
+	// WindChillWarningLow indicates a low risk; wind chill is above -10 °C (14 °F).
+	WindChillWarningLow WindChillWarning = iota
+	// WindChillWarningModerate indicates a moderate risk of hypothermia with prolonged exposure; frostbite is unlikely within 30 minutes.
+	WindChillWarningModerate
+	// WindChillWarningHigh indicates exposed skin may freeze within 30 minutes.
+	WindChillWarningHigh
+	// WindChillWarningVeryHigh indicates exposed skin may freeze within 10 minutes.
+	WindChillWarningVeryHigh
+	// WindChillWarningExtreme indicates exposed skin may freeze within 5 minutes.
+	WindChillWarningExtreme
+)
@@ -0,0 +1,15 @@
+package libwx
+
+// UValue represents thermal transmittance (e.g. of a window) in watts per square meter per kelvin.
+// It is the reciprocal of the total thermal resistance (in m²·K/W).
+type UValue float64
+
+// Typical center-of-glass U-values for common glazing.
+const (
+	UValueSinglePane     UValue = 5.8
+	UValueDoublePane     UValue = 2.8
+	UValueDoublePaneLowE UValue = 1.8
+	UValueTriplePaneLowE UValue = 0.8
+)
+
+func (u UValue) Unwrap() float64 { return float64(u) }
@@ -0,0 +1,86 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_UTCI(t *testing.T) {
+	r := require.New(t)
+	// expected values are rounded to 0.1 degree
+	eq := CurriedFloat64Equal(0.05)
+
+	cases := []struct {
+		t        TempC
+		tmrt     TempC
+		wind     SpeedMps
+		rh       RelHumidity
+		expected TempC
+		category UTCIStressCategory
+	}{
+		{TempC(25), TempC(25), SpeedMps(1), RelHumidity(50), TempC(24.6), UTCINoThermalStress},
+		{TempC(25), TempC(27), SpeedMps(1), RelHumidity(50), TempC(25.2), UTCINoThermalStress},
+		{TempC(30), TempC(60), SpeedMps(3), RelHumidity(50), TempC(35.9), UTCIStrongHeatStress},
+		{TempC(35), TempC(55), SpeedMps(1), RelHumidity(40), TempC(40.4), UTCIVeryStrongHeatStress},
+		{TempC(-10), TempC(-10), SpeedMps(10), RelHumidity(70), TempC(-39.7), UTCIVeryStrongColdStress},
+	}
+
+	for _, c := range cases {
+		e := VaporPressureFromRelC(c.t, c.rh)
+		result, err := UTCIC(c.t, c.tmrt, c.wind, e)
+		r.NoError(err)
+		r.True(eq(result.Unwrap(), c.expected.Unwrap()), "given t %v + tmrt %v + wind %v + rh %v: expected %v, got %v", c.t, c.tmrt, c.wind, c.rh, c.expected, result)
+		r.Equal(c.category, UTCIStressCategoryC(result))
+
+		resultF, err := UTCIF(c.t.F(), c.tmrt.F(), c.wind.KmH(), e)
+		r.NoError(err)
+		r.True(Float64Equal(resultF.C().Unwrap(), result.Unwrap(), Tolerance001))
+		r.Equal(c.category, UTCIStressCategoryF(resultF))
+	}
+
+	e := VaporPressure(10)
+	for _, c := range []struct {
+		t, tmrt TempC
+		wind    SpeedMps
+		e       VaporPressure
+	}{
+		{TempC(-51), TempC(-51), SpeedMps(1), e},
+		{TempC(51), TempC(51), SpeedMps(1), e},
+		{TempC(20), TempC(-11), SpeedMps(1), e},
+		{TempC(20), TempC(91), SpeedMps(1), e},
+		{TempC(20), TempC(20), SpeedMps(0.4), e},
+		{TempC(20), TempC(20), SpeedMps(17.1), e},
+		{TempC(20), TempC(20), SpeedMps(1), VaporPressure(51)},
+	} {
+		_, err := UTCIC(c.t, c.tmrt, c.wind, c.e)
+		r.ErrorIs(err, ErrInputRange, "given t %v + tmrt %v + wind %v + e %v", c.t, c.tmrt, c.wind, c.e)
+	}
+}
+
+func Test_UTCIStressCategory(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		utci     TempC
+		expected UTCIStressCategory
+	}{
+		{TempC(-45), UTCIExtremeColdStress},
+		{TempC(-40), UTCIVeryStrongColdStress},
+		{TempC(-20), UTCIStrongColdStress},
+		{TempC(-5), UTCIModerateColdStress},
+		{TempC(0), UTCISlightColdStress},
+		{TempC(9), UTCINoThermalStress},
+		{TempC(26), UTCINoThermalStress},
+		{TempC(30), UTCIModerateHeatStress},
+		{TempC(35), UTCIStrongHeatStress},
+		{TempC(46), UTCIVeryStrongHeatStress},
+		{TempC(47), UTCIExtremeHeatStress},
+	}
+
+	for _, c := range cases {
+		r.Equal(c.expected, UTCIStressCategoryC(c.utci), "given utci %v", c.utci)
+	}
+
+	r.Equal("No Thermal Stress", UTCINoThermalStress.String())
+}
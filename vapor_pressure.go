@@ -0,0 +1,269 @@
+package libwx
+
+import "math"
+
+// VaporPressureFormula selects a formulation for the saturation vapor pressure
+// of water.
+type VaporPressureFormula int
+
+const (
+	// VaporPressureMagnus is the Magnus formula with the constants recommended by
+	// Alduchov & Eskridge (1996). This is consistent with DewPointC.
+	// Valid from -40 to 50 °C over water, and -80 to 0 °C over ice.
+	VaporPressureMagnus VaporPressureFormula = iota
+	// VaporPressureBuck1981 is the formula from Buck (1981), "New Equations for
+	// Computing Vapor Pressure and Enhancement Factor".
+	// Valid from -40 to 50 °C over water, and -80 to 0 °C over ice.
+	VaporPressureBuck1981
+	// VaporPressureBuck1996 is the updated formula from the Buck Research CR-1A
+	// hygrometer manual (1996).
+	// Valid from -80 to 50 °C over water, and -80 to 0 °C over ice.
+	VaporPressureBuck1996
+	// VaporPressureGoffGratch is the Goff-Gratch (1946) formulation, as adopted
+	// by the WMO.
+	// Valid from -50 to 102 °C over water, and -100 to 0 °C over ice.
+	VaporPressureGoffGratch
+	// VaporPressureHylandWexler is the Hyland & Wexler (1983) formulation, as
+	// used by ASHRAE.
+	// Valid from -100 to 200 °C over water, and -100 to 0 °C over ice.
+	VaporPressureHylandWexler
+	// VaporPressureWagnerPruss is the Wagner & Pruss (2002) saturation equation
+	// from IAPWS-95 over water, and the IAPWS (2011) sublimation equation over ice.
+	// Valid from 0 to 373.946 °C over water, and -223.15 to 0 °C over ice.
+	VaporPressureWagnerPruss
+)
+
+// SaturationSurface selects whether saturation vapor pressure is calculated
+// over a plane surface of liquid water or ice.
+type SaturationSurface int
+
+const (
+	// SaturationOverWater is saturation over liquid water, including
+	// supercooled water below 0 °C.
+	SaturationOverWater SaturationSurface = iota
+	// SaturationOverIce is saturation over ice; it is only defined at or
+	// below 0 °C.
+	SaturationOverIce
+)
+
+// validRangeC returns the range of temperatures (in Celsius) over which the
+// given formula is valid over the given surface.
+func (f VaporPressureFormula) validRangeC(s SaturationSurface) (float64, float64) {
+	if s == SaturationOverIce {
+		switch f {
+		case VaporPressureMagnus, VaporPressureBuck1981, VaporPressureBuck1996:
+			return -80, 0.01
+		case VaporPressureGoffGratch, VaporPressureHylandWexler:
+			return -100, 0.01
+		case VaporPressureWagnerPruss:
+			return -223.15, 0.01
+		}
+		return 0, 0
+	}
+	switch f {
+	case VaporPressureMagnus, VaporPressureBuck1981:
+		return -40, 50
+	case VaporPressureBuck1996:
+		return -80, 50
+	case VaporPressureGoffGratch:
+		return -50, 102
+	case VaporPressureHylandWexler:
+		return -100, 200
+	case VaporPressureWagnerPruss:
+		return 0, 373.946
+	}
+	return 0, 0
+}
+
+// SaturationVaporPressureC calculates the saturation vapor pressure (in millibars)
+// at the given temperature (in Celsius), over the given surface, using the given formula.
+// If the temperature is outside the formula's valid range, ErrInputRange is returned.
+func SaturationVaporPressureC(temp TempC, formula VaporPressureFormula, surface SaturationSurface) (PressureMb, error) {
+	lo, hi := formula.validRangeC(surface)
+	if temp.Unwrap() < lo || temp.Unwrap() > hi {
+		return 0, ErrInputRange
+	}
+	return PressureMb(saturationVaporPressure(temp.Unwrap(), formula, surface)), nil
+}
+
+// SaturationVaporPressureF calculates the saturation vapor pressure (in millibars)
+// at the given temperature (in Fahrenheit), over the given surface, using the given formula.
+// If the temperature is outside the formula's valid range, ErrInputRange is returned.
+func SaturationVaporPressureF(temp TempF, formula VaporPressureFormula, surface SaturationSurface) (PressureMb, error) {
+	return SaturationVaporPressureC(temp.C(), formula, surface)
+}
+
+// saturationVaporPressure returns the saturation vapor pressure (in hPa) at the
+// given temperature (in Celsius), without checking the formula's valid range.
+func saturationVaporPressure(t float64, formula VaporPressureFormula, surface SaturationSurface) float64 {
+	tK := t + 273.15
+	ice := surface == SaturationOverIce
+
+	switch formula {
+	case VaporPressureBuck1981:
+		if ice {
+			return 6.1115 * math.Exp(22.452*t/(272.55+t))
+		}
+		return 6.1121 * math.Exp(17.502*t/(240.97+t))
+	case VaporPressureBuck1996:
+		if ice {
+			return 6.1115 * math.Exp((23.036-t/333.7)*(t/(279.82+t)))
+		}
+		return 6.1121 * math.Exp((18.678-t/234.5)*(t/(257.14+t)))
+	case VaporPressureGoffGratch:
+		if ice {
+			const t0 = 273.16
+			return math.Pow(10,
+				-9.09718*(t0/tK-1)-
+					3.56654*math.Log10(t0/tK)+
+					0.876793*(1-tK/t0)+
+					math.Log10(6.1071),
+			)
+		}
+		const ts = 373.16
+		return math.Pow(10,
+			-7.90298*(ts/tK-1)+
+				5.02808*math.Log10(ts/tK)-
+				1.3816e-7*(math.Pow(10, 11.344*(1-tK/ts))-1)+
+				8.1328e-3*(math.Pow(10, -3.49149*(ts/tK-1))-1)+
+				math.Log10(1013.246),
+		)
+	case VaporPressureHylandWexler:
+		// these formulas give pressure in Pa
+		if ice {
+			return math.Exp(
+				-0.56745359e4/tK+
+					0.63925247e1-
+					0.96778430e-2*tK+
+					0.62215701e-6*tK*tK+
+					0.20747825e-8*tK*tK*tK-
+					0.94840240e-12*tK*tK*tK*tK+
+					0.41635019e1*math.Log(tK),
+			) / 100
+		}
+		return math.Exp(
+			-0.58002206e4/tK+
+				0.13914993e1-
+				0.48640239e-1*tK+
+				0.41764768e-4*tK*tK-
+				0.14452093e-7*tK*tK*tK+
+				0.65459673e1*math.Log(tK),
+		) / 100
+	case VaporPressureWagnerPruss:
+		if ice {
+			const (
+				tt = 273.16
+				pt = 6.11657
+			)
+			theta := tK / tt
+			return pt * math.Exp((-0.212144006e2*math.Pow(theta, 0.333333333e-2)+
+				0.273203819e2*math.Pow(theta, 0.120666667e1)+
+				-0.610598130e1*math.Pow(theta, 0.170333333e1))/theta)
+		}
+		const (
+			tc = 647.096
+			pc = 220640.0
+		)
+		tau := 1 - tK/tc
+		return pc * math.Exp(tc/tK*(-7.85951783*tau+
+			1.84408259*math.Pow(tau, 1.5)+
+			-11.7866497*math.Pow(tau, 3)+
+			22.6807411*math.Pow(tau, 3.5)+
+			-15.9618719*math.Pow(tau, 4)+
+			1.80122502*math.Pow(tau, 7.5)))
+	default:
+		if ice {
+			return magnusIceVaporPressure(t)
+		}
+		return magnusVaporPressure(TempC(t))
+	}
+}
+
+// absHumidityFromVaporPressure returns the absolute humidity (in g/m³) of air
+// at the given temperature (in Celsius) with the given vapor pressure (in hPa).
+func absHumidityFromVaporPressure(e, t float64) float64 {
+	return (e * 100 * 18.016) / (8.314 * (t + 273.15))
+}
+
+// vaporPressureFromAbsHumidity returns the vapor pressure (in hPa) of air at
+// the given temperature (in Celsius) with the given absolute humidity (in g/m³).
+func vaporPressureFromAbsHumidity(ah, t float64) float64 {
+	return (ah * 8.314 * (t + 273.15)) / (18.016 * 100)
+}
+
+// AbsHumidityFromRelFWithFormula calculates absolute humidity from the given
+// temperature (in Fahrenheit) and relative humidity (with respect to water),
+// using the given saturation vapor pressure formula.
+// If the temperature is outside the formula's valid range, ErrInputRange is returned.
+func AbsHumidityFromRelFWithFormula(temp TempF, rh RelHumidity, formula VaporPressureFormula) (AbsHumidity, error) {
+	return AbsHumidityFromRelCWithFormula(temp.C(), rh, formula)
+}
+
+// AbsHumidityFromRelCWithFormula calculates absolute humidity from the given
+// temperature (in Celsius) and relative humidity (with respect to water),
+// using the given saturation vapor pressure formula.
+// If the temperature is outside the formula's valid range, ErrInputRange is returned.
+func AbsHumidityFromRelCWithFormula(temp TempC, rh RelHumidity, formula VaporPressureFormula) (AbsHumidity, error) {
+	pSat, err := SaturationVaporPressureC(temp, formula, SaturationOverWater)
+	if err != nil {
+		return 0, err
+	}
+	e := rh.Clamped().UnwrapFloat64() / 100.0 * pSat.Unwrap()
+	return AbsHumidity(absHumidityFromVaporPressure(e, temp.Unwrap())), nil
+}
+
+// RelHumidityFromAbsFWithFormula calculates relative humidity (with respect to
+// water) from the given temperature (in Fahrenheit) and absolute humidity,
+// using the given saturation vapor pressure formula.
+// If the temperature is outside the formula's valid range, ErrInputRange is returned.
+func RelHumidityFromAbsFWithFormula(temp TempF, ah AbsHumidity, formula VaporPressureFormula) (RelHumidity, error) {
+	return RelHumidityFromAbsCWithFormula(temp.C(), ah, formula)
+}
+
+// RelHumidityFromAbsCWithFormula calculates relative humidity (with respect to
+// water) from the given temperature (in Celsius) and absolute humidity,
+// using the given saturation vapor pressure formula.
+// If the temperature is outside the formula's valid range, ErrInputRange is returned.
+func RelHumidityFromAbsCWithFormula(temp TempC, ah AbsHumidity, formula VaporPressureFormula) (RelHumidity, error) {
+	pSat, err := SaturationVaporPressureC(temp, formula, SaturationOverWater)
+	if err != nil {
+		return 0, err
+	}
+	e := vaporPressureFromAbsHumidity(ah.Unwrap(), temp.Unwrap())
+	return ClampedRelHumidity(int(e/pSat.Unwrap()*100.0 + 0.5)), nil
+}
+
+// DewPointFWithFormula calculates the dew point given the current temperature
+// (in Fahrenheit) and relative humidity, using the given saturation vapor
+// pressure formula.
+// If the temperature or resulting dew point is outside the formula's valid
+// range, or the relative humidity is zero, ErrInputRange is returned.
+func DewPointFWithFormula(temp TempF, rh RelHumidity, formula VaporPressureFormula) (TempF, error) {
+	result, err := DewPointCWithFormula(temp.C(), rh, formula)
+	return result.F(), err
+}
+
+// DewPointCWithFormula calculates the dew point given the current temperature
+// (in Celsius) and relative humidity, using the given saturation vapor
+// pressure formula.
+// If the temperature or resulting dew point is outside the formula's valid
+// range, or the relative humidity is zero, ErrInputRange is returned.
+func DewPointCWithFormula(temp TempC, rh RelHumidity, formula VaporPressureFormula) (TempC, error) {
+	pSat, err := SaturationVaporPressureC(temp, formula, SaturationOverWater)
+	if err != nil {
+		return 0, err
+	}
+	rh = rh.Clamped()
+	if rh == 0 {
+		return 0, ErrInputRange
+	}
+	if rh == 100 {
+		return temp, nil
+	}
+	e := rh.UnwrapFloat64() / 100.0 * pSat.Unwrap()
+	lo, _ := formula.validRangeC(SaturationOverWater)
+	td, err := bisect(func(t float64) float64 {
+		return saturationVaporPressure(t, formula, SaturationOverWater) - e
+	}, lo, temp.Unwrap())
+	return TempC(td), err
+}
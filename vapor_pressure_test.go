@@ -0,0 +1,86 @@
+package libwx
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SaturationVaporPressure_Formulas(t *testing.T) {
+	r := require.New(t)
+
+	formulas := []VaporPressureFormula{
+		VaporPressureMagnus,
+		VaporPressureBuck1981,
+		VaporPressureBuck1996,
+		VaporPressureGoffGratch,
+		VaporPressureHylandWexler,
+		VaporPressureWagnerPruss,
+	}
+
+	// reference values (in hPa) from the Smithsonian Meteorological Tables / CRC Handbook
+	cases := []struct {
+		temp     TempC
+		surface  SaturationSurface
+		expected PressureMb
+	}{
+		{TempC(40), SaturationOverWater, PressureMb(73.85)},
+		{TempC(20), SaturationOverWater, PressureMb(23.39)},
+		{TempC(1), SaturationOverWater, PressureMb(6.571)},
+		{TempC(-20), SaturationOverWater, PressureMb(1.254)},
+		{TempC(0), SaturationOverIce, PressureMb(6.111)},
+		{TempC(-20), SaturationOverIce, PressureMb(1.032)},
+		{TempC(-40), SaturationOverIce, PressureMb(0.1285)},
+	}
+
+	for _, f := range formulas {
+		for _, c := range cases {
+			result, err := SaturationVaporPressureC(c.temp, f, c.surface)
+			lo, hi := f.validRangeC(c.surface)
+			if c.temp.Unwrap() < lo || c.temp.Unwrap() > hi {
+				r.ErrorIs(err, ErrInputRange)
+				continue
+			}
+			msg := fmt.Sprintf("formula %d, surface %d, t %v: expected %v, got %v", f, c.surface, c.temp, c.expected, result)
+			r.NoError(err, msg)
+			r.True(Float64Equal(result.Unwrap()/c.expected.Unwrap(), 1, 0.005), msg)
+		}
+	}
+
+	_, err := SaturationVaporPressureC(TempC(5), VaporPressureMagnus, SaturationOverIce)
+	r.ErrorIs(err, ErrInputRange)
+	_, err = SaturationVaporPressureC(TempC(-60), VaporPressureMagnus, SaturationOverWater)
+	r.ErrorIs(err, ErrInputRange)
+}
+
+func Test_Humidity_WithFormula(t *testing.T) {
+	r := require.New(t)
+
+	for _, f := range []VaporPressureFormula{VaporPressureMagnus, VaporPressureBuck1996, VaporPressureHylandWexler} {
+		ah, err := AbsHumidityFromRelCWithFormula(TempC(20), RelHumidity(50), f)
+		r.NoError(err)
+		r.True(Float64Equal(ah.Unwrap(), 8.65, 0.05), "formula %d: expected 8.65 g/m³, got %v", f, ah)
+
+		rh, err := RelHumidityFromAbsCWithFormula(TempC(20), ah, f)
+		r.NoError(err)
+		r.Equal(RelHumidity(50), rh)
+
+		dp, err := DewPointCWithFormula(TempC(20), RelHumidity(50), f)
+		r.NoError(err)
+		r.True(Float64Equal(dp.Unwrap(), DewPointC(TempC(20), RelHumidity(50)).Unwrap(), Tolerance1), "formula %d: got dew point %v", f, dp)
+	}
+
+	// the Magnus formulation matches DewPointC exactly
+	dp, err := DewPointCWithFormula(TempC(-10), RelHumidity(70), VaporPressureMagnus)
+	r.NoError(err)
+	r.True(Float64Equal(dp.Unwrap(), DewPointC(TempC(-10), RelHumidity(70)).Unwrap(), Tolerance001))
+
+	// below the Antoine equation's -20 °C limit
+	ah, err := AbsHumidityFromRelFWithFormula(TempC(-30).F(), RelHumidity(80), VaporPressureGoffGratch)
+	r.NoError(err)
+	r.True(Float64Equal(ah.Unwrap(), 0.36, 0.01), "expected 0.36 g/m³, got %v", ah)
+
+	_, err = DewPointCWithFormula(TempC(20), RelHumidity(0), VaporPressureMagnus)
+	r.ErrorIs(err, ErrInputRange)
+}
@@ -0,0 +1,81 @@
+package libwx
+
+const (
+	cubicMetersPerCubicFoot = 0.3048 * 0.3048 * 0.3048
+	litersPerGallon         = 3.785411784
+)
+
+// CubicFeet returns the volume in cubic feet.
+func (v CubicMeter) CubicFeet() CubicFoot {
+	return CubicFoot(v / cubicMetersPerCubicFoot)
+}
+
+// Liters returns the volume in liters.
+func (v CubicMeter) Liters() Liter {
+	return Liter(v * 1000)
+}
+
+// Gallons returns the volume in US gallons.
+func (v CubicMeter) Gallons() Gallon {
+	return Gallon(v * 1000 / litersPerGallon)
+}
+
+// CubicMeters returns the volume in cubic meters.
+func (v CubicFoot) CubicMeters() CubicMeter {
+	return CubicMeter(v * cubicMetersPerCubicFoot)
+}
+
+// Liters returns the volume in liters.
+func (v CubicFoot) Liters() Liter {
+	return Liter(v * cubicMetersPerCubicFoot * 1000)
+}
+
+// Gallons returns the volume in US gallons.
+func (v CubicFoot) Gallons() Gallon {
+	return Gallon(v * cubicMetersPerCubicFoot * 1000 / litersPerGallon)
+}
+
+// CubicMeters returns the volume in cubic meters.
+func (v Liter) CubicMeters() CubicMeter {
+	return CubicMeter(v / 1000)
+}
+
+// CubicFeet returns the volume in cubic feet.
+func (v Liter) CubicFeet() CubicFoot {
+	return CubicFoot(v / 1000 / cubicMetersPerCubicFoot)
+}
+
+// Gallons returns the volume in US gallons.
+func (v Liter) Gallons() Gallon {
+	return Gallon(v / litersPerGallon)
+}
+
+// CubicMeters returns the volume in cubic meters.
+func (v Gallon) CubicMeters() CubicMeter {
+	return CubicMeter(v * litersPerGallon / 1000)
+}
+
+// CubicFeet returns the volume in cubic feet.
+func (v Gallon) CubicFeet() CubicFoot {
+	return CubicFoot(v * litersPerGallon / 1000 / cubicMetersPerCubicFoot)
+}
+
+// Liters returns the volume in liters.
+func (v Gallon) Liters() Liter {
+	return Liter(v * litersPerGallon)
+}
+
+// volumeCubicMeters converts any volume type to cubic meters.
+func volumeCubicMeters[V Volume](v V) CubicMeter {
+	switch v := any(v).(type) {
+	case CubicMeter:
+		return v
+	case CubicFoot:
+		return v.CubicMeters()
+	case Liter:
+		return v.CubicMeters()
+	case Gallon:
+		return v.CubicMeters()
+	}
+	panic("unreachable")
+}
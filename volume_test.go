@@ -0,0 +1,25 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_VolumeConversions(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance001)
+
+	r.True(eq(CubicFoot(1).Liters().Unwrap(), 28.317))
+	r.True(eq(CubicMeter(1).CubicFeet().Unwrap(), 35.315))
+	r.True(eq(CubicMeter(1).Gallons().Unwrap(), 264.172))
+	r.True(eq(Gallon(1).Liters().Unwrap(), 3.785))
+	r.True(eq(Gallon(1).CubicFeet().Unwrap(), 0.134))
+	r.True(eq(Liter(1000).CubicMeters().Unwrap(), 1))
+
+	for _, v := range []CubicMeter{0, 1, 42.5} {
+		r.True(eq(v.CubicFeet().Gallons().Liters().CubicMeters().Unwrap(), v.Unwrap()))
+		r.True(eq(v.Liters().CubicFeet().CubicMeters().Unwrap(), v.Unwrap()))
+		r.True(eq(v.Gallons().CubicMeters().Unwrap(), v.Unwrap()))
+	}
+}
@@ -0,0 +1,23 @@
+package libwx
+
+// CubicMeter represents volume in cubic meters.
+type CubicMeter float64
+
+// CubicFoot represents volume in cubic feet.
+type CubicFoot float64
+
+// Liter represents volume in liters.
+type Liter float64
+
+// Gallon represents volume in US gallons.
+type Gallon float64
+
+// Volume is a constraint satisfied by all volume types.
+type Volume interface {
+	CubicMeter | CubicFoot | Liter | Gallon
+}
+
+func (v CubicMeter) Unwrap() float64 { return float64(v) }
+func (v CubicFoot) Unwrap() float64  { return float64(v) }
+func (v Liter) Unwrap() float64      { return float64(v) }
+func (v Gallon) Unwrap() float64     { return float64(v) }
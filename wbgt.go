@@ -0,0 +1,320 @@
+package libwx
+
+import "math"
+
+// This is a port of the WBGT model from Liljegren et al. (2008), "Modeling the
+// Wet Bulb Globe Temperature Using Standard Meteorological Measurements",
+// Journal of Occupational and Environmental Hygiene 5(10).
+// See: https://doi.org/10.1080/15459620802310770
+
+// Physical constants
+const (
+	wbgtSolarConst = 1367.     // W/m^2
+	wbgtStefanB    = 5.6696e-8 // W/m^2/K^4
+	wbgtCp         = 1003.5    // J/kg/K, specific heat capacity of air
+	wbgtMAir       = 28.97     // kg/kmol
+	wbgtMH2O       = 18.015    // kg/kmol
+	wbgtRatio      = wbgtCp * wbgtMAir / wbgtMH2O
+	wbgtRGas       = 8314.34 // J/kmol/K
+	wbgtRAir       = wbgtRGas / wbgtMAir
+	wbgtPr         = wbgtCp / (wbgtCp + 1.25*wbgtRAir) // Prandtl number
+)
+
+// Wick, globe, and surface properties
+const (
+	wbgtEmisWick  = 0.95
+	wbgtAlbWick   = 0.4
+	wbgtDWick     = 0.007  // m
+	wbgtLWick     = 0.0254 // m
+	wbgtEmisGlobe = 0.95
+	wbgtAlbGlobe  = 0.05
+	wbgtDGlobe    = 0.0508 // m
+	wbgtEmisSfc   = 0.999
+	wbgtAlbSfc    = 0.45
+)
+
+// Model parameters
+const (
+	wbgtCzaMin        = 0.00873
+	wbgtNormSolarMax  = 0.85
+	wbgtMinSpeed      = 0.13 // m/s
+	wbgtConvergence   = 0.02 // K
+	wbgtMaxIterations = 50
+)
+
+// WBGTConditions holds the standard meteorological observations needed to
+// estimate the outdoor wet bulb globe temperature.
+type WBGTConditions struct {
+	Temp        TempC
+	RelHumidity RelHumidity
+	Pressure    PressureMb
+	// WindSpeed is the wind speed at 2 m.
+	WindSpeed SpeedMps
+	// SolarIrradiance is the global horizontal solar irradiance.
+	SolarIrradiance Irradiance
+	// SolarZenith is the solar zenith angle; 0 when the sun is directly
+	// overhead and 90 or more when it is at or below the horizon.
+	SolarZenith Degree
+}
+
+// WBGTF estimates the outdoor wet bulb globe temperature (in Fahrenheit) for the
+// given conditions.
+// See WBGTC for details.
+func WBGTF(c WBGTConditions) (TempF, error) {
+	result, err := WBGTC(c)
+	return result.F(), err
+}
+
+// WBGTC estimates the outdoor wet bulb globe temperature (in Celsius) for the
+// given conditions, using the model of Liljegren et al. (2008). This models
+// the globe and natural wet bulb temperatures from first principles, and
+// assumes the Earth is at its mean distance from the sun.
+// If the conditions are invalid or the model does not converge, ErrInputRange
+// is returned.
+func WBGTC(c WBGTConditions) (TempC, error) {
+	if c.Pressure <= 0 || c.WindSpeed < 0 || c.SolarIrradiance < 0 {
+		return c.Temp, ErrInputRange
+	}
+
+	ta := c.Temp.Unwrap() + 273.15
+	rh := c.RelHumidity.Clamped().UnwrapFloat64() / 100.0
+	p := c.Pressure.Unwrap()
+	speed := c.WindSpeed.Unwrap()
+	solar := c.SolarIrradiance.Unwrap()
+	cza := math.Cos(degToRad(c.SolarZenith))
+
+	// estimate the direct beam fraction of solar irradiance; with the sun at or
+	// below the horizon, all of the measured irradiance is treated as diffuse
+	fdir := 0.0
+	if cza >= wbgtCzaMin {
+		toaSolar := wbgtSolarConst * cza
+		normSolar := math.Min(solar/toaSolar, wbgtNormSolarMax)
+		solar = normSolar * toaSolar
+		if normSolar > 0 {
+			fdir = math.Max(math.Min(math.Exp(3-1.34*normSolar-1.65/normSolar), 0.9), 0)
+		}
+	}
+
+	tg, err := wbgtGlobe(ta, rh, p, speed, solar, fdir, cza)
+	if err != nil {
+		return c.Temp, err
+	}
+	tnwb, err := wbgtNaturalWetBulb(ta, rh, p, speed, solar, fdir, cza)
+	if err != nil {
+		return c.Temp, err
+	}
+	return TempC(0.1*ta + 0.2*tg + 0.7*tnwb - 273.15), nil
+}
+
+// WBGTIndoorF estimates the wet bulb globe temperature (in Fahrenheit) indoors
+// or in the shade, given the temperature (in Fahrenheit), relative humidity,
+// and station pressure (in any pressure type).
+// See WBGTIndoorC for details.
+func WBGTIndoorF[P Pressure](temp TempF, rh RelHumidity, p P) (TempF, error) {
+	result, err := WBGTIndoorC(temp.C(), rh, p)
+	return result.F(), err
+}
+
+// WBGTIndoorC estimates the wet bulb globe temperature (in Celsius) indoors
+// or in the shade, given the temperature (in Celsius), relative humidity, and
+// station pressure (in any pressure type), as 0.7 times the wet bulb
+// temperature (per WetBulbCWithPressure) plus 0.3 times the air temperature.
+// This neglects radiant heat, so the globe temperature is assumed to equal the
+// air temperature.
+func WBGTIndoorC[P Pressure](temp TempC, rh RelHumidity, p P) (TempC, error) {
+	tw, err := WetBulbCWithPressure(temp, rh, p)
+	if err != nil {
+		return temp, err
+	}
+	return TempC(0.7*tw.Unwrap() + 0.3*temp.Unwrap()), nil
+}
+
+// WBGTFlag represents a heat category and its flag color, per the US military
+// heat stress guidance (e.g. TB MED 507).
+type WBGTFlag int
+
+const (
+	// WBGTFlagNone indicates WBGT below 78 °F; no flag is flown.
+	WBGTFlagNone WBGTFlag = iota
+	// WBGTFlagWhite indicates heat category 1: WBGT 78 to 81.9 °F.
+	WBGTFlagWhite
+	// WBGTFlagGreen indicates heat category 2: WBGT 82 to 84.9 °F.
+	WBGTFlagGreen
+	// WBGTFlagYellow indicates heat category 3: WBGT 85 to 87.9 °F.
+	WBGTFlagYellow
+	// WBGTFlagRed indicates heat category 4: WBGT 88 to 89.9 °F.
+	WBGTFlagRed
+	// WBGTFlagBlack indicates heat category 5: WBGT 90 °F and above.
+	WBGTFlagBlack
+)
+
+// String returns the flag color.
+func (f WBGTFlag) String() string {
+	switch f {
+	case WBGTFlagNone:
+		return "None"
+	case WBGTFlagWhite:
+		return "White"
+	case WBGTFlagGreen:
+		return "Green"
+	case WBGTFlagYellow:
+		return "Yellow"
+	case WBGTFlagRed:
+		return "Red"
+	case WBGTFlagBlack:
+		return "Black"
+	default:
+		return "Unknown"
+	}
+}
+
+// WBGTFlagF returns the heat category flag for the given wet bulb globe
+// temperature (in Fahrenheit).
+func WBGTFlagF(wbgt TempF) WBGTFlag {
+	if wbgt.Unwrap() < 78 {
+		return WBGTFlagNone
+	}
+	if wbgt.Unwrap() < 82 {
+		return WBGTFlagWhite
+	}
+	if wbgt.Unwrap() < 85 {
+		return WBGTFlagGreen
+	}
+	if wbgt.Unwrap() < 88 {
+		return WBGTFlagYellow
+	}
+	if wbgt.Unwrap() < 90 {
+		return WBGTFlagRed
+	}
+	return WBGTFlagBlack
+}
+
+// WBGTFlagC returns the heat category flag for the given wet bulb globe
+// temperature (in Celsius).
+func WBGTFlagC(wbgt TempC) WBGTFlag {
+	return WBGTFlagF(wbgt.F())
+}
+
+// wbgtGlobe returns the globe temperature (in K).
+func wbgtGlobe(ta, rh, p, speed, solar, fdir, cza float64) (float64, error) {
+	tsfc := ta
+	direct := 0.0
+	if fdir > 0 {
+		direct = fdir * (1/(2*cza) - 1)
+	}
+	prev := ta
+	for i := 0; i < wbgtMaxIterations; i++ {
+		tref := 0.5 * (prev + ta)
+		h := wbgtHSphere(wbgtDGlobe, tref, p, speed)
+		next := math.Pow(
+			0.5*(wbgtEmisAtm(ta, rh)*math.Pow(ta, 4)+wbgtEmisSfc*math.Pow(tsfc, 4))-
+				h/(wbgtStefanB*wbgtEmisGlobe)*(prev-ta)+
+				solar/(2*wbgtStefanB*wbgtEmisGlobe)*(1-wbgtAlbGlobe)*(direct+1+wbgtAlbSfc),
+			0.25)
+		if math.Abs(next-prev) < wbgtConvergence {
+			return next, nil
+		}
+		prev = 0.9*prev + 0.1*next
+	}
+	return 0, ErrInputRange
+}
+
+// wbgtNaturalWetBulb returns the natural wet bulb temperature (in K).
+func wbgtNaturalWetBulb(ta, rh, p, speed, solar, fdir, cza float64) (float64, error) {
+	tsfc := ta
+	sza := math.Acos(cza)
+	eAir := rh * wbgtEsat(ta)
+	direct := 0.0
+	if fdir > 0 {
+		direct = fdir * (math.Tan(sza)/math.Pi + 0.25*wbgtDWick/wbgtLWick)
+	}
+
+	prev := ta - 30
+	if eAir > 0 {
+		prev = wbgtDewPoint(eAir) // first guess is the dew point
+	}
+	for i := 0; i < wbgtMaxIterations; i++ {
+		tref := 0.5 * (prev + ta)
+		h := wbgtHCylinder(wbgtDWick, tref, p, speed)
+		fAtm := wbgtStefanB*wbgtEmisWick*
+			(0.5*(wbgtEmisAtm(ta, rh)*math.Pow(ta, 4)+wbgtEmisSfc*math.Pow(tsfc, 4))-math.Pow(prev, 4)) +
+			(1-wbgtAlbWick)*solar*((1-fdir)*(1+0.25*wbgtDWick/wbgtLWick)+direct+wbgtAlbSfc)
+		eWick := wbgtEsat(prev)
+		density := p * 100 / (wbgtRAir * tref)
+		sc := wbgtViscosity(tref) / (density * wbgtDiffusivity(tref, p))
+		next := ta - wbgtEvap(tref)/wbgtRatio*(eWick-eAir)/(p-eWick)*math.Pow(wbgtPr/sc, 0.56) + fAtm/h
+		if math.Abs(next-prev) < wbgtConvergence {
+			return next, nil
+		}
+		prev = 0.9*prev + 0.1*next
+	}
+	return 0, ErrInputRange
+}
+
+// wbgtEsat returns the saturation vapor pressure (in hPa) over liquid water at
+// the given temperature (in K), per Buck (1981), with an enhancement factor
+// for moist air.
+func wbgtEsat(tk float64) float64 {
+	y := (tk - 273.15) / (tk - 32.18)
+	return 1.004 * 6.1121 * math.Exp(17.502*y)
+}
+
+// wbgtDewPoint returns the dew point (in K) for the given vapor pressure (in hPa).
+func wbgtDewPoint(e float64) float64 {
+	z := math.Log(e / (6.1121 * 1.004))
+	return 273.15 + 240.97*z/(17.502-z)
+}
+
+// wbgtEmisAtm returns the atmospheric emissivity.
+func wbgtEmisAtm(ta, rh float64) float64 {
+	return 0.575 * math.Pow(rh*wbgtEsat(ta), 0.143)
+}
+
+// wbgtViscosity returns the viscosity of air (in kg/m/s) at the given temperature (in K).
+func wbgtViscosity(ta float64) float64 {
+	const sigma, epsKappa = 3.617, 97.0
+	tr := ta / epsKappa
+	omega := (tr-2.9)/0.4*(-0.034) + 1.048
+	return 2.6693e-6 * math.Sqrt(wbgtMAir*ta) / (sigma * sigma * omega)
+}
+
+// wbgtThermalCond returns the thermal conductivity of air (in W/m/K) at the given temperature (in K).
+func wbgtThermalCond(ta float64) float64 {
+	return (wbgtCp + 1.25*wbgtRAir) * wbgtViscosity(ta)
+}
+
+// wbgtDiffusivity returns the diffusivity of water vapor in air (in m^2/s) at
+// the given temperature (in K) and pressure (in hPa).
+func wbgtDiffusivity(ta, p float64) float64 {
+	pcrit13 := math.Pow(36.4*218., 1./3.)
+	tcrit512 := math.Pow(132.*647.3, 5./12.)
+	tcrit12 := math.Sqrt(132. * 647.3)
+	mmix := math.Sqrt(1./wbgtMAir + 1./wbgtMH2O)
+	return 3.640e-4 * math.Pow(ta/tcrit12, 2.334) * pcrit13 * tcrit512 * mmix / (p / 1013.25) * 1e-4
+}
+
+// wbgtEvap returns the heat of evaporation of water (in J/kg) at the given temperature (in K).
+func wbgtEvap(ta float64) float64 {
+	return (313.15-ta)/30.*(-71100.) + 2.4073e6
+}
+
+// wbgtHSphere returns the convective heat transfer coefficient (in W/m^2/K)
+// for flow around a sphere.
+func wbgtHSphere(diameter, ta, p, speed float64) float64 {
+	density := p * 100 / (wbgtRAir * ta)
+	speed = math.Max(speed, wbgtMinSpeed)
+	re := speed * density * diameter / wbgtViscosity(ta)
+	nu := 2.0 + 0.6*math.Sqrt(re)*math.Pow(wbgtPr, 0.3333)
+	return nu * wbgtThermalCond(ta) / diameter
+}
+
+// wbgtHCylinder returns the convective heat transfer coefficient (in W/m^2/K)
+// for flow across a long cylinder.
+func wbgtHCylinder(diameter, ta, p, speed float64) float64 {
+	const a, b, c = 0.56, 0.281, 0.4
+	density := p * 100 / (wbgtRAir * ta)
+	speed = math.Max(speed, wbgtMinSpeed)
+	re := speed * density * diameter / wbgtViscosity(ta)
+	nu := b * math.Pow(re, 1-c) * math.Pow(wbgtPr, 1-a)
+	return nu * wbgtThermalCond(ta) / diameter
+}
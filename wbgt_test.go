@@ -0,0 +1,94 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WBGT(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance01)
+
+	cases := []struct {
+		c        WBGTConditions
+		expected TempC
+		flag     WBGTFlag
+	}{
+		{WBGTConditions{TempC(30), RelHumidity(50), PressureMb(1013), SpeedMps(2), Irradiance(800), Degree(30)}, TempC(28.71), WBGTFlagGreen},
+		{WBGTConditions{TempC(30), RelHumidity(50), PressureMb(1013), SpeedMps(0.5), Irradiance(900), Degree(20)}, TempC(32.52), WBGTFlagBlack},
+		{WBGTConditions{TempC(35), RelHumidity(30), PressureMb(1000), SpeedMps(3), Irradiance(1000), Degree(15)}, TempC(29.42), WBGTFlagGreen},
+		{WBGTConditions{TempC(25), RelHumidity(80), PressureMb(1013), SpeedMps(1), Irradiance(500), Degree(60)}, TempC(27.75), WBGTFlagWhite},
+		{WBGTConditions{TempC(20), RelHumidity(40), PressureMb(850), SpeedMps(5), Irradiance(300), Degree(70)}, TempC(16.00), WBGTFlagNone},
+		// night
+		{WBGTConditions{TempC(30), RelHumidity(50), PressureMb(1013), SpeedMps(2), Irradiance(0), Degree(95)}, TempC(24.16), WBGTFlagNone},
+	}
+
+	for _, c := range cases {
+		result, err := WBGTC(c.c)
+		r.NoError(err)
+		r.True(eq(result.Unwrap(), c.expected.Unwrap()), "given %+v: expected %v, got %v", c.c, c.expected, result)
+		r.Equal(c.flag, WBGTFlagC(result))
+
+		resultF, err := WBGTF(c.c)
+		r.NoError(err)
+		r.True(Float64Equal(resultF.Unwrap(), c.expected.F().Unwrap(), 0.2))
+	}
+
+	// more sun means a higher WBGT
+	shade, err := WBGTC(WBGTConditions{TempC(30), RelHumidity(50), PressureMb(1013), SpeedMps(2), Irradiance(0), Degree(30)})
+	r.NoError(err)
+	sun, err := WBGTC(WBGTConditions{TempC(30), RelHumidity(50), PressureMb(1013), SpeedMps(2), Irradiance(800), Degree(30)})
+	r.NoError(err)
+	r.Greater(sun.Unwrap(), shade.Unwrap())
+
+	// with the sun at the horizon, measured (diffuse) irradiance still counts
+	twilight, err := WBGTC(WBGTConditions{TempC(30), RelHumidity(50), PressureMb(1013), SpeedMps(2), Irradiance(50), Degree(90)})
+	r.NoError(err)
+	dark, err := WBGTC(WBGTConditions{TempC(30), RelHumidity(50), PressureMb(1013), SpeedMps(2), Irradiance(0), Degree(90)})
+	r.NoError(err)
+	r.Greater(twilight.Unwrap(), dark.Unwrap())
+
+	_, err = WBGTC(WBGTConditions{TempC(30), RelHumidity(50), PressureMb(0), SpeedMps(2), Irradiance(800), Degree(30)})
+	r.ErrorIs(err, ErrInputRange)
+	_, err = WBGTC(WBGTConditions{TempC(30), RelHumidity(50), PressureMb(1013), SpeedMps(2), Irradiance(-1), Degree(30)})
+	r.ErrorIs(err, ErrInputRange)
+}
+
+func Test_WBGTIndoor(t *testing.T) {
+	r := require.New(t)
+
+	result, err := WBGTIndoorC(TempC(30), RelHumidity(50), PressureMb(1013))
+	r.NoError(err)
+	r.True(Float64Equal(result.Unwrap(), 24.46, Tolerance01))
+
+	resultF, err := WBGTIndoorF(TempC(30).F(), RelHumidity(50), PressureMb(1013).InHg())
+	r.NoError(err)
+	r.True(Float64Equal(resultF.C().Unwrap(), result.Unwrap(), Tolerance001))
+}
+
+func Test_WBGTFlag(t *testing.T) {
+	r := require.New(t)
+
+	cases := []struct {
+		wbgt     TempF
+		expected WBGTFlag
+	}{
+		{TempF(70), WBGTFlagNone},
+		{TempF(77.9), WBGTFlagNone},
+		{TempF(78), WBGTFlagWhite},
+		{TempF(81.9), WBGTFlagWhite},
+		{TempF(82), WBGTFlagGreen},
+		{TempF(85), WBGTFlagYellow},
+		{TempF(88), WBGTFlagRed},
+		{TempF(89.9), WBGTFlagRed},
+		{TempF(90), WBGTFlagBlack},
+		{TempF(100), WBGTFlagBlack},
+	}
+
+	for _, c := range cases {
+		r.Equal(c.expected, WBGTFlagF(c.wbgt), "given wbgt %v", c.wbgt)
+	}
+
+	r.Equal("Yellow", WBGTFlagYellow.String())
+}
@@ -0,0 +1,82 @@
+package libwx
+
+import "math"
+
+// WetBulbMethod selects a method for calculating wet bulb temperature.
+type WetBulbMethod int
+
+const (
+	// WetBulbStull uses the empirical fit from Stull (2011), as in WetBulbC.
+	// It assumes standard sea level pressure and is valid only for a limited
+	// range of temperature and relative humidity.
+	WetBulbStull WetBulbMethod = iota
+	// WetBulbPsychrometric iteratively solves the psychrometric equation for
+	// the given station pressure, as in WetBulbCWithPressure.
+	WetBulbPsychrometric
+)
+
+// WetBulbFWithPressure calculates the wet bulb temperature (in Fahrenheit) given
+// a dry bulb temperature (in Fahrenheit), relative humidity percentage, and
+// station pressure (in any pressure type).
+//
+// See WetBulbCWithPressure for details.
+func WetBulbFWithPressure[P Pressure](temp TempF, rh RelHumidity, p P) (TempF, error) {
+	result, err := WetBulbCWithPressure(temp.C(), rh, p)
+	return result.F(), err
+}
+
+// WetBulbCWithPressure calculates the wet bulb temperature (in Celsius) given
+// a dry bulb temperature (in Celsius), relative humidity percentage, and
+// station pressure (in any pressure type).
+//
+// Unlike WetBulbC, this solves the psychrometric equation for an aspirated
+// psychrometer (WMO-No. 8) iteratively, and so works over the full range of
+// temperature and relative humidity. When the result is below freezing, the
+// bulb is assumed to be coated in ice, and the ice-bulb temperature is returned.
+// If the pressure is not positive, ErrInputRange is returned.
+func WetBulbCWithPressure[P Pressure](temp TempC, rh RelHumidity, p P) (TempC, error) {
+	pMb := pressureMb(p).Unwrap()
+	if pMb <= 0 || math.IsNaN(temp.Unwrap()) {
+		return temp, ErrInputRange
+	}
+	e := VaporPressureFromRelC(temp, rh).Unwrap()
+	t := temp.Unwrap()
+
+	// below freezing, air saturated with respect to water is supersaturated
+	// with respect to ice, so the ice-bulb temperature may exceed the dry bulb
+	tw, err := bisect(func(tw float64) float64 {
+		bulb := PsychrometerBulbWet
+		if tw < 0 {
+			bulb = PsychrometerBulbIced
+		}
+		return psychrometricVaporPressure(t, tw, pMb, PsychrometerAspirated, bulb) - e
+	}, t-100, t+20)
+	if err != nil {
+		return temp, err
+	}
+	return TempC(tw), nil
+}
+
+// WetBulbFWithMethod calculates the wet bulb temperature (in Fahrenheit) given
+// a dry bulb temperature (in Fahrenheit), relative humidity percentage, and
+// station pressure (in any pressure type), using the chosen method.
+// The pressure is ignored by WetBulbStull.
+func WetBulbFWithMethod[P Pressure](temp TempF, rh RelHumidity, p P, method WetBulbMethod) (TempF, error) {
+	result, err := WetBulbCWithMethod(temp.C(), rh, p, method)
+	return result.F(), err
+}
+
+// WetBulbCWithMethod calculates the wet bulb temperature (in Celsius) given
+// a dry bulb temperature (in Celsius), relative humidity percentage, and
+// station pressure (in any pressure type), using the chosen method.
+// The pressure is ignored by WetBulbStull.
+func WetBulbCWithMethod[P Pressure](temp TempC, rh RelHumidity, p P, method WetBulbMethod) (TempC, error) {
+	switch method {
+	case WetBulbStull:
+		return WetBulbC(temp, rh)
+	case WetBulbPsychrometric:
+		return WetBulbCWithPressure(temp, rh, p)
+	default:
+		return temp, ErrInputRange
+	}
+}
@@ -0,0 +1,64 @@
+package libwx
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WetBulb_WithPressure(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance01)
+
+	cases := []struct {
+		t        TempC
+		rh       RelHumidity
+		p        PressureMb
+		expected TempC
+	}{
+		{TempC(20), RelHumidity(50), PressureMb(1013.25), TempC(13.84)},
+		{TempC(20), RelHumidity(50), PressureMb(700), TempC(12.97)},
+		{TempC(30), RelHumidity(80), PressureMb(1013.25), TempC(27.12)},
+		{TempC(40), RelHumidity(0), PressureMb(1013.25), TempC(14.86)},
+		{TempC(10), RelHumidity(100), PressureMb(1000), TempC(10)},
+		// ice bulb
+		{TempC(-5), RelHumidity(50), PressureMb(1013.25), TempC(-7.12)},
+		{TempC(-30), RelHumidity(20), PressureMb(1000), TempC(-30.45)},
+		{TempC(-10), RelHumidity(100), PressureMb(1013.25), TempC(-9.67)},
+	}
+
+	for _, c := range cases {
+		result, err := WetBulbCWithPressure(c.t, c.rh, c.p)
+		r.NoError(err)
+		r.True(eq(result.Unwrap(), c.expected.Unwrap()), "given t %v + rh %v + p %v: expected %v, got %v", c.t, c.rh, c.p, c.expected, result)
+
+		resultF, err := WetBulbFWithPressure(c.t.F(), c.rh, c.p.InHg())
+		r.NoError(err)
+		r.True(Float64Equal(resultF.C().Unwrap(), result.Unwrap(), Tolerance001))
+	}
+
+	_, err := WetBulbCWithPressure(TempC(20), RelHumidity(50), PressureMb(0))
+	r.ErrorIs(err, ErrInputRange)
+}
+
+func Test_WetBulb_WithMethod(t *testing.T) {
+	r := require.New(t)
+
+	stull, err := WetBulbCWithMethod(TempC(20), RelHumidity(50), PressureMb(1013.25), WetBulbStull)
+	r.NoError(err)
+	expected, _ := WetBulbC(TempC(20), RelHumidity(50))
+	r.Equal(expected, stull)
+
+	psy, err := WetBulbCWithMethod(TempC(20), RelHumidity(50), PressureMb(1013.25), WetBulbPsychrometric)
+	r.NoError(err)
+	r.True(Float64Equal(psy.Unwrap(), stull.Unwrap(), 0.5))
+
+	// outside the Stull validity region, only the psychrometric method succeeds
+	_, err = WetBulbFWithMethod(TempF(100), RelHumidity(2), PressureInHg(29.92), WetBulbStull)
+	r.ErrorIs(err, ErrInputRange)
+	_, err = WetBulbFWithMethod(TempF(100), RelHumidity(2), PressureInHg(29.92), WetBulbPsychrometric)
+	r.NoError(err)
+
+	_, err = WetBulbCWithMethod(TempC(20), RelHumidity(50), PressureMb(1013.25), WetBulbMethod(99))
+	r.ErrorIs(err, ErrInputRange)
+}
@@ -0,0 +1,208 @@
+package libwx
+
+import (
+	"sort"
+	"time"
+)
+
+const (
+	windGustPeriod      = 3 * time.Second
+	windMean2MinPeriod  = 2 * time.Minute
+	windMean10MinPeriod = 10 * time.Minute
+	windPeakPeriod      = time.Hour
+)
+
+// DefaultWindGustThreshold is the amount by which the highest 3-second wind must
+// exceed the 10-minute mean wind for a gust to be reported, per the WMO
+// Manual on Codes (FM 15 METAR).
+const DefaultWindGustThreshold = SpeedKnots(10)
+
+// WindSample is a single time-stamped wind observation.
+type WindSample struct {
+	Time      time.Time
+	Speed     SpeedKnots
+	Direction Degree
+}
+
+// WindMean is the mean wind over some period.
+// Speed is the scalar mean of the sampled speeds; Direction is the
+// speed-weighted circular (vector) mean of the sampled directions.
+type WindMean struct {
+	Speed     SpeedKnots
+	Direction Degree
+	Samples   int
+}
+
+// WindPeak is the highest 3-second mean wind over some period, along with
+// the time at which it occurred.
+type WindPeak struct {
+	Speed     SpeedKnots
+	Direction Degree
+	Time      time.Time
+}
+
+// WindSummary holds the standard WMO/ASOS wind products at a point in time.
+type WindSummary struct {
+	// Mean2Min is the mean wind over the past 2 minutes.
+	Mean2Min WindMean
+	// Mean10Min is the mean wind over the past 10 minutes.
+	Mean10Min WindMean
+	// Gust is the highest 3-second mean wind speed over the past 10 minutes.
+	Gust SpeedKnots
+	// GustReported indicates whether Gust meets the aggregator's gust reporting rule.
+	GustReported bool
+	// Peak is the highest 3-second mean wind over the past hour.
+	Peak WindPeak
+}
+
+// WindAggregator accumulates time-stamped wind samples (typically taken every
+// 1-3 seconds) and produces WMO/ASOS-style wind products from them.
+// Samples older than one hour before the most recent sample are discarded.
+//
+// A WindAggregator is not safe for concurrent use.
+type WindAggregator struct {
+	// GustThreshold is the minimum amount by which the gust must exceed the
+	// 10-minute mean wind speed for the gust to be reported.
+	GustThreshold SpeedKnots
+
+	samples []WindSample
+}
+
+// NewWindAggregator returns a WindAggregator using DefaultWindGustThreshold.
+func NewWindAggregator() *WindAggregator {
+	return &WindAggregator{GustThreshold: DefaultWindGustThreshold}
+}
+
+// Add records the given sample. Samples may be added out of order.
+func (a *WindAggregator) Add(s WindSample) {
+	i := sort.Search(len(a.samples), func(i int) bool {
+		return a.samples[i].Time.After(s.Time)
+	})
+	a.samples = append(a.samples, WindSample{})
+	copy(a.samples[i+1:], a.samples[i:])
+	a.samples[i] = s
+
+	cutoff := a.samples[len(a.samples)-1].Time.Add(-windPeakPeriod)
+	drop := sort.Search(len(a.samples), func(i int) bool {
+		return a.samples[i].Time.After(cutoff)
+	})
+	if drop > 0 {
+		a.samples = append(a.samples[:0], a.samples[drop:]...)
+	}
+}
+
+// Mean2Min returns the mean wind over the 2 minutes ending at now.
+// If no samples fall within that period, ErrInsufficientData is returned.
+func (a *WindAggregator) Mean2Min(now time.Time) (WindMean, error) {
+	return windMean(a.window(now, windMean2MinPeriod))
+}
+
+// Mean10Min returns the mean wind over the 10 minutes ending at now.
+// If no samples fall within that period, ErrInsufficientData is returned.
+func (a *WindAggregator) Mean10Min(now time.Time) (WindMean, error) {
+	return windMean(a.window(now, windMean10MinPeriod))
+}
+
+// Gust returns the highest 3-second mean wind speed over the 10 minutes ending
+// at now, and whether that gust should be reported: a gust is reported only
+// when it exceeds the 10-minute mean speed by at least GustThreshold.
+// If no samples fall within that period, ErrInsufficientData is returned.
+func (a *WindAggregator) Gust(now time.Time) (SpeedKnots, bool, error) {
+	samples := a.window(now, windMean10MinPeriod)
+	mean, err := windMean(samples)
+	if err != nil {
+		return 0, false, err
+	}
+	gust := windPeak(samples)
+	return gust.Speed, gust.Speed-mean.Speed >= a.GustThreshold, nil
+}
+
+// Peak returns the highest 3-second mean wind over the hour ending at now,
+// and the time at which it occurred.
+// If no samples fall within that period, ErrInsufficientData is returned.
+func (a *WindAggregator) Peak(now time.Time) (WindPeak, error) {
+	samples := a.window(now, windPeakPeriod)
+	if len(samples) == 0 {
+		return WindPeak{}, ErrInsufficientData
+	}
+	return windPeak(samples), nil
+}
+
+// Summary returns all the wind products for the given time.
+// If no samples fall within the 2 minutes ending at now, ErrInsufficientData is returned.
+func (a *WindAggregator) Summary(now time.Time) (WindSummary, error) {
+	var (
+		retv WindSummary
+		err  error
+	)
+	if retv.Mean2Min, err = a.Mean2Min(now); err != nil {
+		return WindSummary{}, err
+	}
+	if retv.Mean10Min, err = a.Mean10Min(now); err != nil {
+		return WindSummary{}, err
+	}
+	if retv.Gust, retv.GustReported, err = a.Gust(now); err != nil {
+		return WindSummary{}, err
+	}
+	if retv.Peak, err = a.Peak(now); err != nil {
+		return WindSummary{}, err
+	}
+	return retv, nil
+}
+
+// window returns the samples within the given period ending at (and including) now.
+func (a *WindAggregator) window(now time.Time, period time.Duration) []WindSample {
+	start := now.Add(-period)
+	lo := sort.Search(len(a.samples), func(i int) bool {
+		return a.samples[i].Time.After(start)
+	})
+	hi := sort.Search(len(a.samples), func(i int) bool {
+		return a.samples[i].Time.After(now)
+	})
+	return a.samples[lo:hi]
+}
+
+func windMean(samples []WindSample) (WindMean, error) {
+	if len(samples) == 0 {
+		return WindMean{}, ErrInsufficientData
+	}
+
+	dirs := make([]Degree, len(samples))
+	speeds := make([]float64, len(samples))
+	var sum float64
+	for i, s := range samples {
+		dirs[i] = s.Direction
+		speeds[i] = s.Speed.Unwrap()
+		sum += s.Speed.Unwrap()
+	}
+
+	retv := WindMean{
+		Speed:   SpeedKnots(sum / float64(len(samples))),
+		Samples: len(samples),
+	}
+	if sum == 0 {
+		// all calm; there is no meaningful speed weighting
+		retv.Direction = AvgDirectionDeg(dirs)
+	} else {
+		retv.Direction, _ = WeightedAvgDirectionDeg(dirs, speeds)
+	}
+	return retv, nil
+}
+
+// windPeak returns the highest 3-second mean wind among the given samples,
+// which must be sorted by time and non-empty.
+func windPeak(samples []WindSample) WindPeak {
+	times := make([]time.Time, len(samples))
+	speeds := make([]float64, len(samples))
+	for i, s := range samples {
+		times[i] = s.Time
+		speeds[i] = s.Speed.Unwrap()
+	}
+	_, first, last := maxRunningMean(times, speeds, windGustPeriod)
+	mean, _ := windMean(samples[first : last+1])
+	return WindPeak{
+		Speed:     mean.Speed,
+		Direction: mean.Direction,
+		Time:      samples[last].Time,
+	}
+}
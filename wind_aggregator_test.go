@@ -0,0 +1,85 @@
+package libwx
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WindAggregator_Means(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance01)
+
+	start := time.Date(2024, 7, 17, 12, 0, 0, 0, time.UTC)
+	agg := NewWindAggregator()
+	// 8 minutes of 10 kt from 350, then 2 minutes of 20 kt from 010
+	for i := 1; i <= 600; i++ {
+		s := WindSample{Time: start.Add(time.Duration(i) * time.Second), Speed: 10, Direction: 350}
+		if i > 480 {
+			s.Speed = 20
+			s.Direction = 10
+		}
+		agg.Add(s)
+	}
+	now := start.Add(600 * time.Second)
+
+	m2, err := agg.Mean2Min(now)
+	r.NoError(err)
+	r.Equal(120, m2.Samples)
+	r.True(eq(m2.Speed.Unwrap(), 20), "expected 20 kt, got %v", m2.Speed)
+	r.True(eq(m2.Direction.Unwrap(), 10), "expected 10 deg, got %v", m2.Direction)
+
+	m10, err := agg.Mean10Min(now)
+	r.NoError(err)
+	r.Equal(600, m10.Samples)
+	r.True(eq(m10.Speed.Unwrap(), 12), "expected 12 kt, got %v", m10.Speed)
+	// vector mean weighted by speed: 4800 kt·s from 350 vs. 2400 kt·s from 010
+	r.True(Float64Equal(m10.Direction.Unwrap(), 356.6, Tolerance1), "expected ~356.6 deg, got %v", m10.Direction)
+
+	_, err = agg.Mean2Min(now.Add(time.Hour))
+	r.ErrorIs(err, ErrInsufficientData)
+}
+
+func Test_WindAggregator_Gusts(t *testing.T) {
+	r := require.New(t)
+
+	start := time.Date(2024, 7, 17, 12, 0, 0, 0, time.UTC)
+	agg := NewWindAggregator()
+	// samples are added newest-first to exercise out-of-order insertion
+	for i := 600; i >= 1; i-- {
+		s := WindSample{Time: start.Add(time.Duration(i) * time.Second), Speed: 10, Direction: 270}
+		if i >= 300 && i < 303 {
+			s.Speed = 30
+			s.Direction = 280
+		}
+		agg.Add(s)
+	}
+	now := start.Add(600 * time.Second)
+
+	summary, err := agg.Summary(now)
+	r.NoError(err)
+	r.Equal(SpeedKnots(30), summary.Gust)
+	r.True(summary.GustReported)
+	r.Equal(SpeedKnots(30), summary.Peak.Speed)
+	r.Equal(Degree(280), summary.Peak.Direction)
+	r.Equal(start.Add(302*time.Second), summary.Peak.Time)
+
+	agg.GustThreshold = 25
+	_, reported, err := agg.Gust(now)
+	r.NoError(err)
+	r.False(reported)
+
+	// 10 minutes later, the gust is outside the gust window but still within the peak window
+	for i := 601; i <= 1200; i++ {
+		agg.Add(WindSample{Time: start.Add(time.Duration(i) * time.Second), Speed: 10, Direction: 270})
+	}
+	now = start.Add(1200 * time.Second)
+	gust, reported, err := agg.Gust(now)
+	r.NoError(err)
+	r.Equal(SpeedKnots(10), gust)
+	r.False(reported)
+	peak, err := agg.Peak(now)
+	r.NoError(err)
+	r.Equal(SpeedKnots(30), peak.Speed)
+}
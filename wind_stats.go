@@ -0,0 +1,159 @@
+package libwx
+
+import (
+	"math"
+	"time"
+)
+
+// WindRunMph calculates the wind run (the total distance the air has moved past
+// the observation point) over the period covered by the given time-stamped wind
+// speed samples, which may be irregularly spaced.
+// Speed is integrated between samples using the trapezoidal rule.
+// times must be in chronological order and contain at least two distinct times.
+func WindRunMph(times []time.Time, speeds []SpeedMph) (Mile, error) {
+	run, err := windRunHours(times, speedsFloat64(speeds))
+	return Mile(run), err
+}
+
+// WindRunKmH calculates the wind run (the total distance the air has moved past
+// the observation point) over the period covered by the given time-stamped wind
+// speed samples, which may be irregularly spaced.
+// Speed is integrated between samples using the trapezoidal rule.
+// times must be in chronological order and contain at least two distinct times.
+func WindRunKmH(times []time.Time, speeds []SpeedKmH) (Km, error) {
+	run, err := windRunHours(times, speedsFloat64(speeds))
+	return Km(run), err
+}
+
+// WindRunKnots calculates the wind run (the total distance the air has moved past
+// the observation point) over the period covered by the given time-stamped wind
+// speed samples, which may be irregularly spaced.
+// Speed is integrated between samples using the trapezoidal rule.
+// times must be in chronological order and contain at least two distinct times.
+func WindRunKnots(times []time.Time, speeds []SpeedKnots) (NauticalMile, error) {
+	run, err := windRunHours(times, speedsFloat64(speeds))
+	return NauticalMile(run), err
+}
+
+// GustFactor calculates the gust factor (the ratio of the highest 3-second mean
+// wind speed to the mean wind speed) over the period covered by the given
+// time-stamped wind speed samples, which may be irregularly spaced.
+// The mean is time-weighted.
+// times must be in chronological order and contain at least two distinct times.
+// If the mean wind speed is zero, ErrInputRange is returned.
+func GustFactor[S Speed](times []time.Time, speeds []S) (float64, error) {
+	v := speedsFloat64(speeds)
+	w, err := timeWeights(times, v)
+	if err != nil {
+		return 0, err
+	}
+	mean := weightedMean(v, w)
+	if mean == 0 {
+		return 0, ErrInputRange
+	}
+	gust, _, _ := maxRunningMean(times, v, windGustPeriod)
+	return gust / mean, nil
+}
+
+// TurbulenceIntensity calculates the turbulence intensity (the standard deviation
+// of wind speed divided by the mean wind speed) over the period covered by the
+// given time-stamped wind speed samples, which may be irregularly spaced.
+// The mean and standard deviation are time-weighted.
+// times must be in chronological order and contain at least two distinct times.
+// If the mean wind speed is zero, ErrInputRange is returned.
+func TurbulenceIntensity[S Speed](times []time.Time, speeds []S) (float64, error) {
+	v := speedsFloat64(speeds)
+	w, err := timeWeights(times, v)
+	if err != nil {
+		return 0, err
+	}
+	mean := weightedMean(v, w)
+	if mean == 0 {
+		return 0, ErrInputRange
+	}
+	var sumSq, sumW float64
+	for i, x := range v {
+		sumSq += w[i] * (x - mean) * (x - mean)
+		sumW += w[i]
+	}
+	return math.Sqrt(sumSq/sumW) / mean, nil
+}
+
+func speedsFloat64[S Speed](in []S) []float64 {
+	retv := make([]float64, len(in))
+	for i, v := range in {
+		retv[i] = float64(v)
+	}
+	return retv
+}
+
+// windRunHours integrates the given per-hour values over time, returning the
+// result in the values' distance unit.
+func windRunHours(times []time.Time, v []float64) (float64, error) {
+	w, err := timeWeights(times, v)
+	if err != nil {
+		return 0, err
+	}
+	var run float64
+	for i, x := range v {
+		run += w[i] * x
+	}
+	return run, nil
+}
+
+// timeWeights returns the trapezoidal-rule weight (in hours) of each sample
+// taken at the given times: half the interval to each neighboring sample.
+func timeWeights(times []time.Time, v []float64) ([]float64, error) {
+	if len(times) != len(v) {
+		return nil, ErrMismatchedInputLength
+	}
+	if len(times) < 2 {
+		return nil, ErrInsufficientData
+	}
+	w := make([]float64, len(times))
+	for i := 1; i < len(times); i++ {
+		dt := times[i].Sub(times[i-1]).Hours()
+		if dt < 0 {
+			return nil, ErrUnsortedInput
+		}
+		w[i-1] += dt / 2
+		w[i] += dt / 2
+	}
+	if times[len(times)-1].Equal(times[0]) {
+		return nil, ErrInsufficientData
+	}
+	return w, nil
+}
+
+func weightedMean(v, w []float64) float64 {
+	var sum, sumW float64
+	for i, x := range v {
+		sum += w[i] * x
+		sumW += w[i]
+	}
+	return sum / sumW
+}
+
+// maxRunningMean returns the highest mean of the values within any period
+// ending at one of the given times, along with the indices of the first and
+// last samples within that period. times must be in chronological order and
+// non-empty.
+//
+// This defines the 3-second gust for both GustFactor and WindAggregator.
+func maxRunningMean(times []time.Time, v []float64, period time.Duration) (mean float64, first, last int) {
+	var (
+		sum float64
+		lo  int
+	)
+	for hi := range v {
+		sum += v[hi]
+		for !times[lo].After(times[hi].Add(-period)) {
+			sum -= v[lo]
+			lo++
+		}
+		if m := sum / float64(hi-lo+1); hi == 0 || m > mean {
+			mean, first, last = m, lo, hi
+		}
+	}
+	return mean, first, last
+}
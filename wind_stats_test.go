@@ -0,0 +1,96 @@
+package libwx
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_WindRun(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance001)
+
+	start := time.Date(2024, 7, 17, 0, 0, 0, 0, time.UTC)
+	// irregularly spaced samples: 10 mph for the first hour, ramping to 20 mph
+	// over the next 30 minutes, then 20 mph for 30 minutes
+	times := []time.Time{
+		start,
+		start.Add(10 * time.Minute),
+		start.Add(60 * time.Minute),
+		start.Add(90 * time.Minute),
+		start.Add(120 * time.Minute),
+	}
+	speeds := []SpeedMph{10, 10, 10, 20, 20}
+
+	run, err := WindRunMph(times, speeds)
+	r.NoError(err)
+	r.True(eq(run.Unwrap(), 10+7.5+10), "expected 27.5 mi, got %v", run)
+
+	kmSpeeds := make([]SpeedKmH, len(speeds))
+	for i, s := range speeds {
+		kmSpeeds[i] = s.KmH()
+	}
+	runKm, err := WindRunKmH(times, kmSpeeds)
+	r.NoError(err)
+	r.True(eq(runKm.Unwrap(), run.Km().Unwrap()), "expected %v km, got %v", run.Km(), runKm)
+
+	_, err = WindRunMph(times[:1], speeds[:1])
+	r.ErrorIs(err, ErrInsufficientData)
+	_, err = WindRunMph(times, speeds[1:])
+	r.ErrorIs(err, ErrMismatchedInputLength)
+	_, err = WindRunMph([]time.Time{times[1], times[0]}, speeds[:2])
+	r.ErrorIs(err, ErrUnsortedInput)
+}
+
+func Test_GustFactor_TurbulenceIntensity(t *testing.T) {
+	r := require.New(t)
+	eq := CurriedFloat64Equal(Tolerance001)
+
+	start := time.Date(2024, 7, 17, 0, 0, 0, 0, time.UTC)
+	// 10 minutes of 1 Hz samples alternating between 8 and 12 knots,
+	// with one 3-second gust to 20 knots
+	var (
+		times  []time.Time
+		speeds []SpeedKnots
+	)
+	for i := 0; i <= 600; i++ {
+		times = append(times, start.Add(time.Duration(i)*time.Second))
+		s := SpeedKnots(8)
+		if i%2 == 1 {
+			s = 12
+		}
+		if i >= 300 && i < 303 {
+			s = 20
+		}
+		speeds = append(speeds, s)
+	}
+
+	gf, err := GustFactor(times, speeds)
+	r.NoError(err)
+	// trapezoidal mean: (8*301 + 12*300 + (12+8+12) - 8/2 - 8/2) / 600
+	mean := 6032.0 / 600.0
+	r.True(eq(gf, 20/mean), "expected gust factor %.3f, got %.3f", 20/mean, gf)
+
+	ti, err := TurbulenceIntensity(times, speeds)
+	r.NoError(err)
+	// trapezoidal mean square: (64*301 + 144*300 + (336+256+336) - 64/2 - 64/2) / 600
+	stdDev := math.Sqrt(63328.0/600.0 - mean*mean)
+	r.True(eq(ti, stdDev/mean), "expected turbulence intensity %.3f, got %.3f", stdDev/mean, ti)
+	r.True(eq(ti, 0.2105))
+
+	steady := make([]SpeedKnots, len(times))
+	for i := range steady {
+		steady[i] = 10
+	}
+	ti, err = TurbulenceIntensity(times, steady)
+	r.NoError(err)
+	r.True(eq(ti, 0))
+	gf, err = GustFactor(times, steady)
+	r.NoError(err)
+	r.True(eq(gf, 1))
+
+	_, err = TurbulenceIntensity(times, make([]SpeedKnots, len(times)))
+	r.ErrorIs(err, ErrInputRange)
+}